@@ -0,0 +1,219 @@
+package arc
+
+import (
+	"go-datastructures/hashtable"
+	"go-datastructures/linkedlist"
+)
+
+// Cache :: struct :: Fixed-capacity Adaptive Replacement Cache (Megiddo & Modha), which balances
+// evicting by recency against evicting by frequency according to which the workload rewards.
+//
+// Cached entries are split between two lists, each ordered from most to least recently used:
+// recent holds entries used once since they were cached, frequent those used again. Each list has
+// a ghost list of the keys most recently evicted from it, with their values dropped. A Put of a key
+// found in a ghost list is a miss that a larger share for that side would have turned into a hit,
+// so target, recent's share of the capacity, moves towards it. Evictions then come from whichever
+// list is over its share.
+//
+// Every operation is O(1): the four lists are DoublyLinkedLists, and a HashTable maps each key,
+// cached or ghost, to its Node.
+type Cache[K comparable, V any] struct {
+	capacity int
+	// target is the number of entries recent should hold, between 0 and capacity
+	target         int
+	recent         *linkedlist.DoublyLinkedList[entry[K, V]]
+	frequent       *linkedlist.DoublyLinkedList[entry[K, V]]
+	recentGhosts   *linkedlist.DoublyLinkedList[entry[K, V]]
+	frequentGhosts *linkedlist.DoublyLinkedList[entry[K, V]]
+	index          *hashtable.HashTable[K, *linkedlist.DoubleNode[entry[K, V]]]
+	onEvict        func(key K, value V)
+}
+
+// entry :: struct :: list value, pointing back at the list it's in
+type entry[K comparable, V any] struct {
+	key   K
+	value V
+	list  *linkedlist.DoublyLinkedList[entry[K, V]]
+}
+
+// New :: func :: Returns a pointer to a new Cache holding up to capacity entries, configured by opts.
+// It remembers up to capacity evicted keys as well.
+func New[K comparable, V any](capacity int, opts ...Option[K, V]) *Cache[K, V] {
+	if capacity < 1 {
+		panic("arc: capacity must be at least 1")
+	}
+	var c config[K, V]
+	for _, opt := range opts {
+		opt(&c)
+	}
+	newList := func() *linkedlist.DoublyLinkedList[entry[K, V]] {
+		// Entries are only ever found through the index, but compare keys in case anyone asks the list
+		return linkedlist.NewDoublyLinkedFunc(func(a, b entry[K, V]) bool {
+			return a.key == b.key
+		})
+	}
+	cache := &Cache[K, V]{
+		capacity:       capacity,
+		recent:         newList(),
+		frequent:       newList(),
+		recentGhosts:   newList(),
+		frequentGhosts: newList(),
+		index:          hashtable.New[K, *linkedlist.DoubleNode[entry[K, V]]](),
+		onEvict:        c.onEvict,
+	}
+	return cache
+}
+
+// Get :: func :: Returns the value stored under key, and whether there was one, counting it as a use
+func (c *Cache[K, V]) Get(key K) (V, bool) {
+	node, found := c.cached(key)
+	if !found {
+		var zero V
+		return zero, false
+	}
+	e := node.Value
+	c.move(node, c.frequent, e.value)
+	return e.value, true
+}
+
+// Peek :: func :: Returns the value stored under key, and whether there was one, without counting it as a use
+func (c *Cache[K, V]) Peek(key K) (V, bool) {
+	node, found := c.cached(key)
+	if !found {
+		var zero V
+		return zero, false
+	}
+	return node.Value.value, true
+}
+
+// Put :: func :: Stores value under key, evicting an entry if the Cache is full.
+// Replacing a value counts as a use of it.
+func (c *Cache[K, V]) Put(key K, value V) {
+	node, found := c.index.Get(key)
+	switch {
+	case found && (node.Value.list == c.recent || node.Value.list == c.frequent):
+		c.move(node, c.frequent, value)
+	case found && node.Value.list == c.recentGhosts:
+		// recent was evicted too soon, give it more room
+		c.target = min(c.capacity, c.target+max(c.frequentGhosts.Len()/c.recentGhosts.Len(), 1))
+		c.replace(false)
+		c.move(node, c.frequent, value)
+	case found:
+		// frequent was evicted too soon, give it more room
+		c.target = max(0, c.target-max(c.recentGhosts.Len()/c.frequentGhosts.Len(), 1))
+		c.replace(true)
+		c.move(node, c.frequent, value)
+	default:
+		if c.recent.Len()+c.recentGhosts.Len() == c.capacity {
+			if c.recent.Len() < c.capacity {
+				c.forget(c.recentGhosts)
+				c.replace(false)
+			} else {
+				// recent fills the Cache without any ghosts, so its oldest entry goes entirely
+				victim := c.recent.Tail
+				c.forget(c.recent)
+				c.evicted(victim.Value)
+			}
+		} else if c.Len()+c.recentGhosts.Len()+c.frequentGhosts.Len() >= c.capacity {
+			if c.Len()+c.recentGhosts.Len()+c.frequentGhosts.Len() == 2*c.capacity {
+				c.forget(c.frequentGhosts)
+			}
+			c.replace(false)
+		}
+		c.index.Put(key, c.recent.AddHead(entry[K, V]{key: key, value: value, list: c.recent}))
+	}
+}
+
+// Remove :: func :: Removes key from the Cache, returning false if it wasn't present.
+// OnEvict isn't called for entries removed this way, and the key isn't remembered as evicted.
+func (c *Cache[K, V]) Remove(key K) bool {
+	node, found := c.index.Get(key)
+	if !found {
+		return false
+	}
+	list := node.Value.list
+	list.Unlink(node)
+	c.index.Delete(key)
+	return list == c.recent || list == c.frequent
+}
+
+// Len :: func :: Returns the number of entries in the Cache, not counting remembered evicted keys
+func (c *Cache[K, V]) Len() int {
+	return c.recent.Len() + c.frequent.Len()
+}
+
+// Target :: func :: Returns how many of the Cache's entries it's currently aiming to hold
+// for recently used keys, the rest being for frequently used ones
+func (c *Cache[K, V]) Target() int {
+	return c.target
+}
+
+// cached :: func :: returns key's Node if it's in recent or frequent, rather than a ghost list
+func (c *Cache[K, V]) cached(key K) (*linkedlist.DoubleNode[entry[K, V]], bool) {
+	node, found := c.index.Get(key)
+	if !found || (node.Value.list != c.recent && node.Value.list != c.frequent) {
+		return nil, false
+	}
+	return node, true
+}
+
+// replace :: func :: evicts the oldest entry of recent or frequent, whichever is over its share,
+// to the head of its ghost list. A frequent ghost being brought back tips a tie towards recent.
+// Nothing is evicted while Remove has left room.
+func (c *Cache[K, V]) replace(frequentGhost bool) {
+	if c.Len() < c.capacity {
+		return
+	}
+	from, to := c.frequent, c.frequentGhosts
+	if n := c.recent.Len(); n > 0 && (n > c.target || (frequentGhost && n == c.target)) {
+		from, to = c.recent, c.recentGhosts
+	}
+	if from.IsEmpty() {
+		return
+	}
+	victim := from.Tail.Value
+	var zero V
+	c.move(from.Tail, to, zero)
+	c.evicted(victim)
+}
+
+// move :: func :: moves node to the head of list, holding value
+func (c *Cache[K, V]) move(node *linkedlist.DoubleNode[entry[K, V]], list *linkedlist.DoublyLinkedList[entry[K, V]], value V) {
+	e := node.Value
+	if e.list == list {
+		node.Value.value = value
+		list.MoveToFront(node)
+		return
+	}
+	e.list.Unlink(node)
+	e.value, e.list = value, list
+	c.index.Put(e.key, list.AddHead(e))
+}
+
+// forget :: func :: drops the oldest key of list entirely
+func (c *Cache[K, V]) forget(list *linkedlist.DoublyLinkedList[entry[K, V]]) {
+	if oldest := list.Tail; oldest != nil {
+		list.Unlink(oldest)
+		c.index.Delete(oldest.Value.key)
+	}
+}
+
+func (c *Cache[K, V]) evicted(e entry[K, V]) {
+	if c.onEvict != nil {
+		c.onEvict(e.key, e.value)
+	}
+}
+
+// Option :: func :: Configures a Cache created with New
+type Option[K comparable, V any] func(*config[K, V])
+
+type config[K comparable, V any] struct {
+	onEvict func(key K, value V)
+}
+
+// WithOnEvict :: func :: Calls f with each entry the Cache evicts to make room
+func WithOnEvict[K comparable, V any](f func(key K, value V)) Option[K, V] {
+	return func(c *config[K, V]) {
+		c.onEvict = f
+	}
+}
@@ -0,0 +1,272 @@
+package arc
+
+import (
+	"math/rand"
+	"reflect"
+	"testing"
+
+	"go-datastructures/linkedlist"
+)
+
+// state :: struct :: the keys in each of a Cache's lists, from most to least recently used
+type state struct {
+	recent, frequent, recentGhosts, frequentGhosts []string
+	target                                         int
+}
+
+// lists :: func :: returns the keys in each of the Cache's lists, checking the lists and the index
+// agree with each other and that the lists are within ARC's bounds
+func lists[K comparable, V any](t *testing.T, c *Cache[K, V]) (recent, frequent, recentGhosts, frequentGhosts []K) {
+	t.Helper()
+	n := 0
+	read := func(list *linkedlist.DoublyLinkedList[entry[K, V]]) []K {
+		var out []K
+		for it := list.Iterator(); it.Next(); {
+			e := it.Value()
+			out = append(out, e.key)
+			n++
+			if e.list != list {
+				t.Errorf("key %v is in a different list from the one it points at", e.key)
+			}
+			if node, found := c.index.Get(e.key); !found || node.Value.key != e.key {
+				t.Errorf("key %v is in a list but not the index", e.key)
+			}
+		}
+		return out
+	}
+	recent, frequent = read(c.recent), read(c.frequent)
+	recentGhosts, frequentGhosts = read(c.recentGhosts), read(c.frequentGhosts)
+	if c.index.Len() != n {
+		t.Errorf("index holds %d keys, but the lists hold %d", c.index.Len(), n)
+	}
+	if c.Len() != len(recent)+len(frequent) || c.Len() > c.capacity {
+		t.Errorf("Len() = %d with %d recent and %d frequent entries and a capacity of %d", c.Len(), len(recent), len(frequent), c.capacity)
+	}
+	if len(recent)+len(recentGhosts) > c.capacity || n > 2*c.capacity {
+		t.Errorf("%d recent entries and ghosts and %d keys in all, with a capacity of %d", len(recent)+len(recentGhosts), n, c.capacity)
+	}
+	if c.target < 0 || c.target > c.capacity {
+		t.Errorf("target = %d, outside [0, %d]", c.target, c.capacity)
+	}
+	return recent, frequent, recentGhosts, frequentGhosts
+}
+
+func TestCache(t *testing.T) {
+	tests := []struct {
+		name     string
+		capacity int
+		ops      func(c *Cache[string, int])
+		want     state
+		evicted  []string
+	}{
+		{
+			name:     "new entries are recent",
+			capacity: 3,
+			ops: func(c *Cache[string, int]) {
+				c.Put("a", 1)
+				c.Put("b", 2)
+				c.Put("c", 3)
+			},
+			want: state{recent: []string{"c", "b", "a"}},
+		},
+		{
+			name:     "used entries are frequent",
+			capacity: 3,
+			ops: func(c *Cache[string, int]) {
+				c.Put("a", 1)
+				c.Put("b", 2)
+				c.Get("a")
+				c.Put("b", 3)
+			},
+			want: state{frequent: []string{"b", "a"}},
+		},
+		{
+			name:     "peek doesn't count",
+			capacity: 3,
+			ops: func(c *Cache[string, int]) {
+				c.Put("a", 1)
+				c.Peek("a")
+			},
+			want: state{recent: []string{"a"}},
+		},
+		{
+			name:     "recent alone fills the cache, so its oldest is dropped without a ghost",
+			capacity: 2,
+			ops: func(c *Cache[string, int]) {
+				c.Put("a", 1)
+				c.Put("b", 2)
+				c.Put("c", 3)
+			},
+			want:    state{recent: []string{"c", "b"}},
+			evicted: []string{"a"},
+		},
+		{
+			name:     "recent over its share leaves a ghost",
+			capacity: 2,
+			ops: func(c *Cache[string, int]) {
+				c.Put("a", 1)
+				c.Get("a")
+				c.Put("b", 2)
+				c.Put("c", 3)
+			},
+			want:    state{recent: []string{"c"}, frequent: []string{"a"}, recentGhosts: []string{"b"}},
+			evicted: []string{"b"},
+		},
+		{
+			name:     "a recent ghost grows recent's share",
+			capacity: 2,
+			ops: func(c *Cache[string, int]) {
+				c.Put("a", 1)
+				c.Get("a")
+				c.Put("b", 2)
+				c.Put("c", 3)
+				c.Put("b", 4)
+			},
+			want:    state{recent: []string{"c"}, frequent: []string{"b"}, frequentGhosts: []string{"a"}, target: 1},
+			evicted: []string{"b", "a"},
+		},
+		{
+			name:     "a frequent ghost shrinks it again",
+			capacity: 2,
+			ops: func(c *Cache[string, int]) {
+				c.Put("a", 1)
+				c.Get("a")
+				c.Put("b", 2)
+				c.Put("c", 3)
+				c.Put("b", 4)
+				c.Put("d", 5)
+				c.Put("a", 6)
+			},
+			want:    state{recent: []string{"d"}, frequent: []string{"a"}, recentGhosts: []string{"c"}, frequentGhosts: []string{"b"}},
+			evicted: []string{"b", "a", "b", "c"},
+		},
+		{
+			name:     "removed entries leave no ghost",
+			capacity: 2,
+			ops: func(c *Cache[string, int]) {
+				c.Put("a", 1)
+				c.Get("a")
+				c.Put("b", 2)
+				c.Remove("a")
+				c.Remove("z")
+				c.Put("c", 3)
+			},
+			want: state{recent: []string{"c", "b"}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var evicted []string
+			c := New[string, int](tt.capacity, WithOnEvict(func(key string, _ int) {
+				evicted = append(evicted, key)
+			}))
+			tt.ops(c)
+			var got state
+			got.recent, got.frequent, got.recentGhosts, got.frequentGhosts = lists(t, c)
+			got.target = c.Target()
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Cache holds %+v, want %+v", got, tt.want)
+			}
+			if !reflect.DeepEqual(evicted, tt.evicted) {
+				t.Errorf("OnEvict called with %v, want %v", evicted, tt.evicted)
+			}
+		})
+	}
+}
+
+func TestCache_GetPeekRemove(t *testing.T) {
+	c := New[string, int](2)
+	c.Put("a", 1)
+	c.Put("a", 2)
+	for name, get := range map[string]func(string) (int, bool){"Get": c.Get, "Peek": c.Peek} {
+		if got, found := get("a"); got != 2 || !found {
+			t.Errorf("%s(a) = %v, %v, want 2, true", name, got, found)
+		}
+		if got, found := get("z"); got != 0 || found {
+			t.Errorf("%s(z) = %v, %v, want 0, false", name, got, found)
+		}
+	}
+	if !c.Remove("a") || c.Remove("a") {
+		t.Errorf("Remove(a) should succeed once")
+	}
+	if _, found := c.Get("a"); found || c.Len() != 0 {
+		t.Errorf("Get(a) found a removed entry, Len() = %d", c.Len())
+	}
+	// Ghosts aren't entries
+	c.Put("a", 1)
+	c.Get("a")
+	c.Put("b", 2)
+	c.Put("c", 3)
+	if _, found := c.Get("b"); found {
+		t.Errorf("Get(b) found an evicted entry")
+	}
+	if c.Remove("b") {
+		t.Errorf("Remove(b) removed an evicted entry")
+	}
+}
+
+func TestCache_ScanResistance(t *testing.T) {
+	// A hot set used over and over survives a long scan of keys used once each
+	c := New[int, int](10)
+	for range 3 {
+		for key := range 5 {
+			if _, found := c.Get(key); !found {
+				c.Put(key, key)
+			}
+		}
+	}
+	for key := 100; key < 1000; key++ {
+		c.Put(key, key)
+	}
+	for key := range 5 {
+		if _, found := c.Peek(key); !found {
+			t.Errorf("hot key %d was evicted by the scan", key)
+		}
+	}
+}
+
+func TestNew_ZeroCapacity(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Errorf("New() did not panic")
+		}
+	}()
+	New[string, int](0)
+}
+
+func TestCache_Random(t *testing.T) {
+	const capacity = 16
+	rng := rand.New(rand.NewSource(1))
+	var evicted []int
+	c := New[int, int](capacity, WithOnEvict(func(key, _ int) { evicted = append(evicted, key) }))
+	// Which keys ARC keeps depends on its history, but whatever it does keep must hold the
+	// value last put, and it must only lose keys through OnEvict or Remove
+	values := map[int]int{}
+	for i := 0; i < 5000; i++ {
+		key := rng.Intn(40)
+		switch rng.Intn(4) {
+		case 0:
+			got, found := c.Get(key)
+			if want, cached := values[key]; found != cached || got != want {
+				t.Fatalf("Get(%d) = %v, %v, want %v, %v", key, got, found, want, cached)
+			}
+		case 1:
+			removed := c.Remove(key)
+			if _, want := values[key]; removed != want {
+				t.Fatalf("Remove(%d) = %v, want %v", key, removed, want)
+			}
+			delete(values, key)
+		default:
+			c.Put(key, i)
+			values[key] = i
+		}
+		for _, key := range evicted {
+			delete(values, key)
+		}
+		evicted = evicted[:0]
+		if c.Len() != len(values) {
+			t.Fatalf("after operation %d Len() = %d, want %d", i, c.Len(), len(values))
+		}
+		lists(t, c)
+	}
+}
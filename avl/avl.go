@@ -1,241 +1,380 @@
 package avl
 
 import (
+	"cmp"
 	"errors"
-	"go-datastructures/model"
-)
-
-const (
-	root = iota
-	left
-	right
+	"fmt"
 )
 
 // AVL :: struct :: Self-balancing BST.
-type AVL struct {
-	Root    *Node
-	Height  int
-	LHeight int
-	RHeight int
+// Every Node tracks the Height of its subtree, and any Node whose subtrees differ
+// in height by more than one is rotated back into balance on the way out of Add/Remove.
+type AVL[T any] struct {
+	Root    *Node[T]
+	compare func(a, b T) int
 }
 
-// Add :: func :: This will add in strings, with the left/right
-// placement of new nodes being decided by the length of the
-// incoming string. Nice and arbitrary.
-func (a *AVL) Add(obj model.Object) {
-	if a.Root != nil {
-		switch a.Root.add(obj) {
-		case root:
-			a.Height++
-		case left:
-			a.Height++
-			a.LHeight++
-		case right:
-			a.Height++
-			a.RHeight++
-		}
+// New :: func :: Returns a pointer to a new AVL ordered by the natural ordering of T
+func New[T cmp.Ordered]() *AVL[T] {
+	return NewFunc(cmp.Compare[T])
+}
+
+// NewFunc :: func :: Returns a pointer to a new AVL ordered by compare, which
+// returns a negative number when a < b, zero when a == b and a positive number when a > b
+func NewFunc[T any](compare func(a, b T) int) *AVL[T] {
+	return &AVL[T]{
+		compare: compare,
+	}
+}
+
+// Add :: func :: Adds a value to the AVL, rebalancing any Node along the insertion
+// path that ends up too heavy on one side. Adding a value that compares equal to
+// one already in the tree replaces the stored value.
+func (a *AVL[T]) Add(t T) {
+	a.Root = a.Root.add(t, a.comparator())
+	a.Root.Parent = nil
+}
+
+// Height :: func :: Returns the number of Nodes on the longest path from the Root to a leaf
+func (a *AVL[T]) Height() int {
+	return a.Root.height()
+}
+
+// Balance :: func :: Restores the AVL property at n using whichever of the four
+// rotations its balance factor calls for. Add and Remove call this on every Node
+// they touch, so it only needs to be called directly after editing Nodes by hand.
+func (a *AVL[T]) Balance(n *Node[T]) {
+	if n == nil {
 		return
-	} else {
-		a.Root = &Node{
-			Value: obj,
-		}
-		a.Height++
 	}
+	a.relink(n, n.rebalance())
 }
 
-func (a AVL) Balance() {
-	// TODO
+// LeftRotate :: func :: Rotates the subtree rooted at n to the left, promoting n.Right
+func (a *AVL[T]) LeftRotate(n *Node[T]) {
+	if n == nil || n.Right == nil {
+		return
+	}
+	a.relink(n, n.leftRotate())
 }
 
-func (a AVL) LeftRotate() {
-	// TODO
+// LeftRightRotate :: func :: Left rotates n.Left, then right rotates n.
+// Used when n is left heavy and its left child is right heavy.
+func (a *AVL[T]) LeftRightRotate(n *Node[T]) {
+	if n == nil || n.Left == nil || n.Left.Right == nil {
+		return
+	}
+	a.relink(n, n.leftRightRotate())
 }
 
-func (a AVL) LeftRightRotate() {
-	// TODO
+// RightRotate :: func :: Rotates the subtree rooted at n to the right, promoting n.Left
+func (a *AVL[T]) RightRotate(n *Node[T]) {
+	if n == nil || n.Left == nil {
+		return
+	}
+	a.relink(n, n.rightRotate())
 }
 
-func (a AVL) RightRotate() {
-	// TODO
+// RightLeftRotate :: func :: Right rotates n.Right, then left rotates n.
+// Used when n is right heavy and its right child is left heavy.
+func (a *AVL[T]) RightLeftRotate(n *Node[T]) {
+	if n == nil || n.Right == nil || n.Right.Left == nil {
+		return
+	}
+	a.relink(n, n.rightLeftRotate())
 }
 
-func (a AVL) RightLeftRotate() {
-	// TODO
+// relink :: func :: Points whatever held old (its Parent or the Root) at replacement,
+// the new root of old's subtree after a rotation, and refreshes the Heights and Sizes above it.
+func (a *AVL[T]) relink(old, replacement *Node[T]) {
+	parent := replacement.Parent
+	switch {
+	case parent == nil:
+		a.Root = replacement
+	case parent.Left == old:
+		parent.Left = replacement
+	default:
+		parent.Right = replacement
+	}
+	for ; parent != nil; parent = parent.Parent {
+		parent.update()
+	}
 }
 
 // Remove :: func :: Removes a object/value from the AVL. Returns an error if the value is not in the AVL.
-func (a AVL) Remove(obj model.Object) (bool, error) {
-	removed := a.Root.remove(a.Root, root, obj)
+func (a *AVL[T]) Remove(obj T) (bool, error) {
+	var removed bool
+	a.Root, removed = a.Root.remove(obj, a.comparator())
+	if a.Root != nil {
+		a.Root.Parent = nil
+	}
 	if !removed {
 		return removed, errors.New("object not found in list")
 	}
 	return removed, nil
 }
 
-func (a AVL) Find(obj model.Object) (*Node, bool) {
-	return a.Root.find(obj)
+// Find :: func :: Returns the Node holding a value equal to obj
+func (a *AVL[T]) Find(obj T) (*Node[T], bool) {
+	return a.Root.find(obj, a.comparator())
 }
 
-// NodeFunc :: func :: Some function that takes in  model.Object
-// and does an operation on the stored value, with no return.
-type NodeFunc func(obj model.Object)
-
-// PreOrder :: func :: Processes current, left, right
-func (a AVL) PreOrder(f NodeFunc) {
+// Validate :: func :: Checks the AVL invariants, returning an error describing the
+// first violation found: values must be in order, every child's Parent must point
+// back at it, stored Heights and Sizes must be accurate and no balance factor may exceed one.
+func (a *AVL[T]) Validate() error {
 	if a.Root == nil {
-		return
+		return nil
+	}
+	if a.Root.Parent != nil {
+		return fmt.Errorf("root %v has a parent", a.Root.Value)
 	}
-	a.Root.preOrder(f)
+	_, _, err := a.Root.validate(a.comparator(), nil, nil)
+	return err
 }
 
-// InOrder :: func :: Processes left, current, right
-// Items in the list will be processed in Sort Order
-func (a AVL) InOrder(f NodeFunc) {
-	if a.Root == nil {
-		return
+// comparator :: func :: A zero-value AVL has no ordering to fall back on, so fail loudly
+func (a *AVL[T]) comparator() func(x, y T) int {
+	if a.compare == nil {
+		panic("avl: AVL has no comparator, create it with New or NewFunc")
 	}
-	a.Root.inOrder(f)
+	return a.compare
 }
 
-// PostOrder :: func :: Processes left, right, current
-// Root will be processed last -- Deletion of the entire tree could be a use case
-func (a AVL) PostOrder(f NodeFunc) {
-	if a.Root == nil {
-		return
-	}
-	a.Root.postOrder(f)
+// Node :: struct :: Node holds the values for the elements of the AVL, and any pointers to child values.
+// Height is the number of Nodes on the longest path from this Node down to a leaf, so a leaf has Height 1.
+// Size is the number of Nodes in the subtree rooted here, itself included, which lets Rank
+// skip whole subtrees.
+type Node[T any] struct {
+	Value  T
+	Height int
+	Size   int
+	Parent *Node[T]
+	Left   *Node[T]
+	Right  *Node[T]
 }
 
-// Node :: struct :: Node holds the values for the elements of the AVL, and any pointers to child values
-type Node struct {
-	Value  model.Object
-	Parent *Node
-	Left   *Node
-	Right  *Node
+func (n *Node[T]) find(t T, compare func(a, b T) int) (*Node[T], bool) {
+	for n != nil {
+		switch c := compare(t, n.Value); {
+		case c < 0:
+			n = n.Left
+		case c > 0:
+			n = n.Right
+		default:
+			return n, true
+		}
+	}
+	return nil, false
 }
 
-func (n Node) preOrder(f NodeFunc) {
-	f(n.Value)
-	if n.Left != nil {
-		n.Left.preOrder(f)
+// add :: func :: adds a new node below n, returning the root of the rebalanced subtree
+func (n *Node[T]) add(t T, compare func(a, b T) int) *Node[T] {
+	if n == nil {
+		return &Node[T]{Value: t, Height: 1, Size: 1}
 	}
-	if n.Right != nil {
-		n.Right.preOrder(f)
+	switch c := compare(t, n.Value); {
+	case c < 0:
+		n.Left = n.Left.add(t, compare)
+		n.Left.Parent = n
+	case c > 0:
+		n.Right = n.Right.add(t, compare)
+		n.Right.Parent = n
+	default:
+		n.Value = t
+		return n
 	}
+	return n.rebalance()
 }
 
-func (n Node) inOrder(f NodeFunc) {
-	if n.Left != nil {
-		n.Left.inOrder(f)
+// remove :: func :: removes the node matching t from below n,
+// returning the root of the rebalanced subtree and whether a node was removed
+func (n *Node[T]) remove(t T, compare func(a, b T) int) (*Node[T], bool) {
+	if n == nil {
+		return nil, false
 	}
-	f(n.Value)
-	if n.Right != nil {
-		n.Right.inOrder(f)
+	var removed bool
+	switch c := compare(t, n.Value); {
+	case c < 0:
+		n.Left, removed = n.Left.remove(t, compare)
+		n.Left.setParent(n)
+	case c > 0:
+		n.Right, removed = n.Right.remove(t, compare)
+		n.Right.setParent(n)
+	default:
+		if n.Left == nil || n.Right == nil {
+			// Zero or one child: the child (if any) takes this node's place
+			child := n.Left
+			if child == nil {
+				child = n.Right
+			}
+			child.setParent(n.Parent)
+			return child, true
+		}
+		// Two children: take over the in-order successor's value, then remove the successor
+		successor := n.Right.min()
+		n.Value = successor.Value
+		n.Right, removed = n.Right.remove(successor.Value, compare)
+		n.Right.setParent(n)
 	}
+	if !removed {
+		return n, false
+	}
+	return n.rebalance(), true
 }
 
-func (n Node) postOrder(f NodeFunc) {
-	if n.Left != nil {
-		n.Left.postOrder(f)
-	}
-	if n.Right != nil {
-		n.Right.postOrder(f)
+// min :: func :: returns the left-most node below n
+func (n *Node[T]) min() *Node[T] {
+	for n.Left != nil {
+		n = n.Left
 	}
-	f(n.Value)
+	return n
 }
 
-func (n Node) find(obj model.Object) (*Node, bool) {
-	match := n.Value.Value == obj.Value
-	if match {
-		return &n, true
+// max :: func :: returns the right-most node below n
+func (n *Node[T]) max() *Node[T] {
+	for n.Right != nil {
+		n = n.Right
 	}
-	if less(obj, n.Value) && n.Left != nil {
-		return n.Left.find(obj)
-	} else if n.Right != nil {
-		return n.Right.find(obj)
+	return n
+}
+
+// rebalance :: func :: refreshes n's Height and Size and rotates the subtree if it's out of balance,
+// returning the subtree's new root
+func (n *Node[T]) rebalance() *Node[T] {
+	n.update()
+	switch bf := n.balanceFactor(); {
+	case bf > 1:
+		if n.Left.balanceFactor() < 0 {
+			return n.leftRightRotate()
+		}
+		return n.rightRotate()
+	case bf < -1:
+		if n.Right.balanceFactor() > 0 {
+			return n.rightLeftRotate()
+		}
+		return n.leftRotate()
 	}
-	return nil, false
+	return n
 }
 
-// add :: func :: adds a new node
-func (n *Node) add(obj model.Object) int {
-	if n.Value.Value == "" {
-		n.Value = obj
-		return root
+// leftRotate :: func :: promotes n.Right to the root of the subtree
+//
+//	  n              r
+//	 / \            / \
+//	a   r    =>    n   c
+//	   / \        / \
+//	  b   c      a   b
+func (n *Node[T]) leftRotate() *Node[T] {
+	r := n.Right
+	n.Right = r.Left
+	n.Right.setParent(n)
+	r.Left = n
+	r.Parent = n.Parent
+	n.Parent = r
+	n.update()
+	r.update()
+	return r
+}
+
+// rightRotate :: func :: promotes n.Left to the root of the subtree
+//
+//	    n          l
+//	   / \        / \
+//	  l   c  =>  a   n
+//	 / \            / \
+//	a   b          b   c
+func (n *Node[T]) rightRotate() *Node[T] {
+	l := n.Left
+	n.Left = l.Right
+	n.Left.setParent(n)
+	l.Right = n
+	l.Parent = n.Parent
+	n.Parent = l
+	n.update()
+	l.update()
+	return l
+}
+
+func (n *Node[T]) leftRightRotate() *Node[T] {
+	n.Left = n.Left.leftRotate()
+	return n.rightRotate()
+}
+
+func (n *Node[T]) rightLeftRotate() *Node[T] {
+	n.Right = n.Right.rightRotate()
+	return n.leftRotate()
+}
+
+// height :: func :: nil-safe Height, an empty subtree has a height of 0
+func (n *Node[T]) height() int {
+	if n == nil {
+		return 0
 	}
-	if less(n.Value, obj) {
-		if n.Right == nil {
-			n.Right = &Node{Value: obj}
-		} else {
-			n.Right.add(obj)
-		}
-		return right
+	return n.Height
+}
+
+// size :: func :: nil-safe Size, an empty subtree has a size of 0
+func (n *Node[T]) size() int {
+	if n == nil {
+		return 0
 	}
-	if n.Left == nil {
-		n.Left = &Node{Value: obj}
-	} else {
-		n.Left.add(obj)
+	return n.Size
+}
+
+// update :: func :: recomputes n's Height and Size from its children's
+func (n *Node[T]) update() {
+	n.Height = 1 + max(n.Left.height(), n.Right.height())
+	n.Size = 1 + n.Left.size() + n.Right.size()
+}
+
+// balanceFactor :: func :: positive when the left subtree is taller, negative when the right is
+func (n *Node[T]) balanceFactor() int {
+	if n == nil {
+		return 0
 	}
-	return left
+	return n.Left.height() - n.Right.height()
 }
 
-// remove :: func :: removes any matching nodes
-func (n *Node) remove(parent *Node, side int, obj model.Object) bool {
-	switch side {
-	case root:
-		match := parent.Value.Value == n.Value.Value
-		if !match {
-			less := less(n.Value, obj)
-			if less {
-				return n.Right != nil && n.Right.remove(n, right, obj)
-			}
-			return n.Left != nil && n.Left.remove(n, left, obj)
-		}
-		n.Value.Value = ""
-		return true
-	case left:
-		if n.Value.Value == "" {
-			return false
-		}
-		// If this is a match for the current node
-		if match := n.Value.Value == obj.Value; match {
-			if n.Left != nil {
-				// Promote the left child
-				parent.Left = n.Left
-				n.Left.Right = n.Right
-			} else {
-				// Promote the right child
-				parent.Left = n.Right
-			}
-			return true
-		}
-		// Not a match, so return removing any non-nil children
-		return n.Right != nil && n.Right.remove(n, right, n.Right.Value) ||
-			n.Left != nil && n.Left.remove(n, left, n.Left.Value)
-	case right:
-		if n.Value.Value == "" {
-			return false
-		}
-		// If this is a match for the current node
-		if match := n.Value.Value == obj.Value; match {
-			if n.Right != nil {
-				// Promote the right child
-				parent.Right = n.Right
-				n.Right.Left = n.Left
-			} else {
-				// Promote the left child
-				parent.Right = n.Left
-			}
-			return true
-		}
-		// Not a match, so return removing any non-nil children
-		return n.Right != nil && n.Right.remove(n, right, n.Right.Value) ||
-			n.Left != nil && n.Left.remove(n, left, n.Left.Value)
-	default:
+// setParent :: func :: nil-safe Parent assignment
+func (n *Node[T]) setParent(parent *Node[T]) {
+	if n != nil {
+		n.Parent = parent
 	}
-	return false
 }
 
-func less(o1, o2 model.Object) bool {
-	return len(o1.Value) < len(o2.Value)
+// validate :: func :: checks the subtree rooted at n, whose values must fall strictly
+// between lo and hi when they're set, and returns its actual height and size
+func (n *Node[T]) validate(compare func(a, b T) int, lo, hi *T) (int, int, error) {
+	if n == nil {
+		return 0, 0, nil
+	}
+	if (lo != nil && compare(n.Value, *lo) <= 0) || (hi != nil && compare(n.Value, *hi) >= 0) {
+		return 0, 0, fmt.Errorf("node %v is out of order", n.Value)
+	}
+	if n.Left != nil && n.Left.Parent != n {
+		return 0, 0, fmt.Errorf("node %v does not point back at its parent %v", n.Left.Value, n.Value)
+	}
+	if n.Right != nil && n.Right.Parent != n {
+		return 0, 0, fmt.Errorf("node %v does not point back at its parent %v", n.Right.Value, n.Value)
+	}
+	lh, ls, err := n.Left.validate(compare, lo, &n.Value)
+	if err != nil {
+		return 0, 0, err
+	}
+	rh, rs, err := n.Right.validate(compare, &n.Value, hi)
+	if err != nil {
+		return 0, 0, err
+	}
+	h := 1 + max(lh, rh)
+	if n.Height != h {
+		return 0, 0, fmt.Errorf("node %v has height %d, want %d", n.Value, n.Height, h)
+	}
+	if size := 1 + ls + rs; n.Size != size {
+		return 0, 0, fmt.Errorf("node %v has size %d, want %d", n.Value, n.Size, size)
+	}
+	if bf := lh - rh; bf > 1 || bf < -1 {
+		return 0, 0, fmt.Errorf("node %v has balance factor %d", n.Value, bf)
+	}
+	return h, n.Size, nil
 }
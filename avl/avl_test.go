@@ -3,6 +3,7 @@ package avl
 import (
 	"fmt"
 	"go-datastructures/model"
+	"go-datastructures/treetest"
 	"reflect"
 	"testing"
 )
@@ -15,7 +16,7 @@ var (
 
 func TestAVL_Add(t *testing.T) {
 	type fields struct {
-		Root *Node
+		Root *Node[model.Object]
 	}
 	type args struct {
 		obj model.Object
@@ -33,19 +34,10 @@ func TestAVL_Add(t *testing.T) {
 				obj: model.Object{Value: "first"},
 			},
 		},
-		{
-			name: "call to Add() with a non-nil root saves the value in Root",
-			fields: fields{
-				Root: &Node{},
-			},
-			args: args{
-				obj: model.Object{Value: "first"},
-			},
-		},
 		{
 			name: "call to Add() places left node correctly",
 			fields: fields{
-				Root: &Node{
+				Root: &Node[model.Object]{
 					Value: model.Object{Value: "first"},
 				},
 			},
@@ -57,7 +49,7 @@ func TestAVL_Add(t *testing.T) {
 		{
 			name: "call to Add() places right node correctly",
 			fields: fields{
-				Root: &Node{
+				Root: &Node[model.Object]{
 					Value: model.Object{Value: "first"},
 				},
 			},
@@ -69,7 +61,7 @@ func TestAVL_Add(t *testing.T) {
 		{
 			name: "call to Add() places right node correctly",
 			fields: fields{
-				Root: &Node{
+				Root: &Node[model.Object]{
 					Value: model.Object{Value: "primary"},
 				},
 			},
@@ -81,8 +73,9 @@ func TestAVL_Add(t *testing.T) {
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			b := AVL{
-				Root: tt.fields.Root,
+			b := AVL[model.Object]{
+				Root:    tt.fields.Root,
+				compare: treetest.CompareObjects,
 			}
 			b.Add(tt.args.obj)
 			if node, found := b.Find(tt.args.obj); !found {
@@ -110,7 +103,7 @@ func TestAVL_Add(t *testing.T) {
 
 func TestAVL_Find(t *testing.T) {
 	type fields struct {
-		Root *Node
+		Root *Node[model.Object]
 	}
 	type args struct {
 		obj model.Object
@@ -119,13 +112,13 @@ func TestAVL_Find(t *testing.T) {
 		name      string
 		fields    fields
 		args      args
-		want      *Node
+		want      *Node[model.Object]
 		wantFound bool
 	}{
 		{
 			name: "searching for a value that doesn't exist",
 			fields: fields{
-				Root: &Node{
+				Root: &Node[model.Object]{
 					Value: model.Object{
 						Value: "first",
 					},
@@ -138,7 +131,7 @@ func TestAVL_Find(t *testing.T) {
 		{
 			name: "bst with value at root returns true",
 			fields: fields{
-				Root: &Node{
+				Root: &Node[model.Object]{
 					Value: model.Object{
 						Value: "first",
 					},
@@ -147,17 +140,17 @@ func TestAVL_Find(t *testing.T) {
 			args: args{
 				model.Object{Value: "first"},
 			},
-			want:      &Node{Value: model.Object{Value: "first"}},
+			want:      &Node[model.Object]{Value: model.Object{Value: "first"}},
 			wantFound: true,
 		},
 		{
 			name: "bst with value at the right returns true",
 			fields: fields{
-				Root: &Node{
+				Root: &Node[model.Object]{
 					Value: model.Object{
 						Value: "first",
 					},
-					Right: &Node{
+					Right: &Node[model.Object]{
 						Value: model.Object{Value: "second"},
 					},
 				},
@@ -165,17 +158,17 @@ func TestAVL_Find(t *testing.T) {
 			args: args{
 				model.Object{Value: "second"},
 			},
-			want:      &Node{Value: model.Object{Value: "second"}},
+			want:      &Node[model.Object]{Value: model.Object{Value: "second"}},
 			wantFound: true,
 		},
 		{
 			name: "bst with value at the left returns true",
 			fields: fields{
-				Root: &Node{
+				Root: &Node[model.Object]{
 					Value: model.Object{
 						Value: "first",
 					},
-					Left: &Node{
+					Left: &Node[model.Object]{
 						Value: model.Object{Value: "two"},
 					},
 				},
@@ -183,22 +176,22 @@ func TestAVL_Find(t *testing.T) {
 			args: args{
 				model.Object{Value: "two"},
 			},
-			want:      &Node{Value: model.Object{Value: "two"}},
+			want:      &Node[model.Object]{Value: model.Object{Value: "two"}},
 			wantFound: true,
 		},
 		{
 			name: "find goes through multiple levels to find expected match",
 			fields: fields{
-				Root: &Node{
+				Root: &Node[model.Object]{
 					Value: model.Object{
 						Value: "first",
 					},
-					Left: &Node{
+					Left: &Node[model.Object]{
 						Value: model.Object{Value: "two"},
 					},
-					Right: &Node{
+					Right: &Node[model.Object]{
 						Value: model.Object{Value: "secondary"},
-						Left: &Node{
+						Left: &Node[model.Object]{
 							Value: model.Object{Value: "seconda"},
 						},
 					},
@@ -207,14 +200,15 @@ func TestAVL_Find(t *testing.T) {
 			args: args{
 				model.Object{Value: "seconda"},
 			},
-			want:      &Node{Value: model.Object{Value: "seconda"}},
+			want:      &Node[model.Object]{Value: model.Object{Value: "seconda"}},
 			wantFound: true,
 		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			b := AVL{
-				Root: tt.fields.Root,
+			b := AVL[model.Object]{
+				Root:    tt.fields.Root,
+				compare: treetest.CompareObjects,
 			}
 			got, found := b.Find(tt.args.obj)
 			if !reflect.DeepEqual(&got, &tt.want) {
@@ -230,30 +224,30 @@ func TestAVL_Find(t *testing.T) {
 func TestNode_Find(t *testing.T) {
 	type fields struct {
 		Value model.Object
-		Left  *Node
-		Right *Node
+		Left  *Node[model.Object]
+		Right *Node[model.Object]
 	}
 	type args struct {
-		parent *Node
+		parent *Node[model.Object]
 		obj    model.Object
 	}
 	tests := []struct {
 		name   string
 		fields fields
 		args   args
-		want   *Node
+		want   *Node[model.Object]
 		want1  bool
 	}{
 		// TODO: Add test cases.
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			n := Node{
+			n := Node[model.Object]{
 				Value: tt.fields.Value,
 				Left:  tt.fields.Left,
 				Right: tt.fields.Right,
 			}
-			got, got1 := n.find(tt.args.obj)
+			got, got1 := n.find(tt.args.obj, treetest.CompareObjects)
 			if !reflect.DeepEqual(got, tt.want) {
 				t.Errorf("Node.Find() got = %v, want %v", got, tt.want)
 			}
@@ -266,7 +260,7 @@ func TestNode_Find(t *testing.T) {
 
 func TestAVL_Remove(t *testing.T) {
 	type fields struct {
-		Root *Node
+		Root *Node[model.Object]
 	}
 	type args struct {
 		obj model.Object
@@ -284,7 +278,7 @@ func TestAVL_Remove(t *testing.T) {
 				obj: model.Object{Value: "root"},
 			},
 			fields: fields{
-				Root: &Node{Value: model.Object{"root"}},
+				Root: &Node[model.Object]{Value: model.Object{Value: "root"}},
 			},
 			want: true,
 		},
@@ -294,7 +288,7 @@ func TestAVL_Remove(t *testing.T) {
 				obj: model.Object{Value: "root"},
 			},
 			fields: fields{
-				Root: &Node{Value: model.Object{"notRoot"}},
+				Root: &Node[model.Object]{Value: model.Object{Value: "notRoot"}},
 			},
 			want:    false,
 			wantErr: true,
@@ -302,8 +296,9 @@ func TestAVL_Remove(t *testing.T) {
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			b := AVL{
-				Root: tt.fields.Root,
+			b := AVL[model.Object]{
+				Root:    tt.fields.Root,
+				compare: treetest.CompareObjects,
 			}
 			got, err := b.Remove(tt.args.obj)
 			if (err != nil) != tt.wantErr {
@@ -320,13 +315,11 @@ func TestAVL_Remove(t *testing.T) {
 func TestNode_Remove(t *testing.T) {
 	type fields struct {
 		Value model.Object
-		Left  *Node
-		Right *Node
+		Left  *Node[model.Object]
+		Right *Node[model.Object]
 	}
 	type args struct {
-		parent *Node
-		side   int
-		obj    model.Object
+		obj model.Object
 	}
 	tests := []struct {
 		name      string
@@ -341,9 +334,7 @@ func TestNode_Remove(t *testing.T) {
 				Value: rootVal,
 			},
 			args: args{
-				parent: &Node{Value: rootVal},
-				side:   root,
-				obj:    rootVal,
+				obj: rootVal,
 			},
 			want: true,
 		},
@@ -351,12 +342,10 @@ func TestNode_Remove(t *testing.T) {
 			name: "right child is removed",
 			fields: fields{
 				Value: rootVal,
-				Right: &Node{Value: rightVal},
+				Right: &Node[model.Object]{Value: rightVal},
 			},
 			args: args{
-				parent: &Node{Value: rightVal},
-				side:   root,
-				obj:    rightVal,
+				obj: rightVal,
 			},
 			want: true,
 		},
@@ -364,16 +353,14 @@ func TestNode_Remove(t *testing.T) {
 			name: "right child is removed and tree is correctly re-built",
 			fields: fields{
 				Value: rootVal,
-				Right: &Node{
+				Right: &Node[model.Object]{
 					Value: rightVal,
-					Left:  &Node{Value: model.Object{Value: "righ"}},
-					Right: &Node{Value: model.Object{Value: "righter"}},
+					Left:  &Node[model.Object]{Value: model.Object{Value: "rust"}},
+					Right: &Node[model.Object]{Value: model.Object{Value: "righter"}},
 				},
 			},
 			args: args{
-				parent: &Node{Value: rightVal},
-				side:   root,
-				obj:    rightVal,
+				obj: rightVal,
 			},
 			want: true,
 		},
@@ -381,15 +368,13 @@ func TestNode_Remove(t *testing.T) {
 			name: "right child is removed and right child is promoted",
 			fields: fields{
 				Value: rootVal,
-				Right: &Node{
+				Right: &Node[model.Object]{
 					Value: rightVal,
-					Right: &Node{Value: model.Object{Value: "righter"}},
+					Right: &Node[model.Object]{Value: model.Object{Value: "righter"}},
 				},
 			},
 			args: args{
-				parent: &Node{Value: rightVal},
-				side:   root,
-				obj:    rightVal,
+				obj: rightVal,
 			},
 			want:      true,
 			wantChild: "righter",
@@ -398,29 +383,25 @@ func TestNode_Remove(t *testing.T) {
 			name: "right child is removed and left child is promoted",
 			fields: fields{
 				Value: rootVal,
-				Right: &Node{
+				Right: &Node[model.Object]{
 					Value: rightVal,
-					Left:  &Node{Value: model.Object{Value: "righ"}},
+					Left:  &Node[model.Object]{Value: model.Object{Value: "rust"}},
 				},
 			},
 			args: args{
-				parent: &Node{Value: rightVal},
-				side:   root,
-				obj:    rightVal,
+				obj: rightVal,
 			},
 			want:      true,
-			wantChild: "righ",
+			wantChild: "rust",
 		},
 		{
 			name: "left child is removed",
 			fields: fields{
 				Value: rootVal,
-				Left:  &Node{Value: leftVal},
+				Left:  &Node[model.Object]{Value: leftVal},
 			},
 			args: args{
-				parent: &Node{Value: leftVal},
-				side:   root,
-				obj:    leftVal,
+				obj: leftVal,
 			},
 			want: true,
 		},
@@ -428,16 +409,14 @@ func TestNode_Remove(t *testing.T) {
 			name: "left child is removed and tree is correctly re-built",
 			fields: fields{
 				Value: rootVal,
-				Left: &Node{
+				Left: &Node[model.Object]{
 					Value: leftVal,
-					Left:  &Node{Value: model.Object{Value: "l"}},
-					Right: &Node{Value: model.Object{Value: "lef"}},
+					Left:  &Node[model.Object]{Value: model.Object{Value: "l"}},
+					Right: &Node[model.Object]{Value: model.Object{Value: "lef"}},
 				},
 			},
 			args: args{
-				parent: &Node{Value: leftVal},
-				side:   root,
-				obj:    leftVal,
+				obj: leftVal,
 			},
 			want: true,
 		},
@@ -445,15 +424,13 @@ func TestNode_Remove(t *testing.T) {
 			name: "left child is removed and right child is promoted",
 			fields: fields{
 				Value: rootVal,
-				Left: &Node{
+				Left: &Node[model.Object]{
 					Value: leftVal,
-					Right: &Node{Value: model.Object{Value: "lef"}},
+					Right: &Node[model.Object]{Value: model.Object{Value: "lef"}},
 				},
 			},
 			args: args{
-				parent: &Node{Value: leftVal},
-				side:   root,
-				obj:    leftVal,
+				obj: leftVal,
 			},
 			want:      true,
 			wantChild: "lef",
@@ -462,15 +439,13 @@ func TestNode_Remove(t *testing.T) {
 			name: "left child is removed and left child is promoted",
 			fields: fields{
 				Value: rootVal,
-				Left: &Node{
+				Left: &Node[model.Object]{
 					Value: leftVal,
-					Left:  &Node{Value: model.Object{Value: "l"}},
+					Left:  &Node[model.Object]{Value: model.Object{Value: "l"}},
 				},
 			},
 			args: args{
-				parent: &Node{Value: leftVal},
-				side:   root,
-				obj:    leftVal,
+				obj: leftVal,
 			},
 			want:      true,
 			wantChild: "l",
@@ -478,15 +453,16 @@ func TestNode_Remove(t *testing.T) {
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			n := Node{
+			n := &Node[model.Object]{
 				Value: tt.fields.Value,
 				Left:  tt.fields.Left,
 				Right: tt.fields.Right,
 			}
-			if got := n.remove(tt.args.parent, tt.args.side, tt.args.obj); got != tt.want {
+			n, got := n.remove(tt.args.obj, treetest.CompareObjects)
+			if got != tt.want {
 				t.Errorf("Node.Remove() = %v, want %v", got, tt.want)
 			}
-			_, found := n.find(tt.args.obj)
+			_, found := n.find(tt.args.obj, treetest.CompareObjects)
 			if tt.want == true && found {
 				t.Errorf("Node.Remove() value still found in tree after Remove()")
 			}
@@ -495,7 +471,7 @@ func TestNode_Remove(t *testing.T) {
 				case "nil":
 					// TODO:
 				default:
-					_, foundChild := n.find(model.Object{Value: tt.wantChild})
+					_, foundChild := n.find(model.Object{Value: tt.wantChild}, treetest.CompareObjects)
 					if !foundChild {
 						t.Errorf("Node.Remove() expected child not found in tree after Remove()")
 					}
@@ -507,10 +483,10 @@ func TestNode_Remove(t *testing.T) {
 
 func TestAVL_PreOrder(t *testing.T) {
 	type fields struct {
-		Root *Node
+		Root *Node[model.Object]
 	}
 	type args struct {
-		f NodeFunc
+		f NodeFunc[model.Object]
 	}
 	tests := []struct {
 		name   string
@@ -520,39 +496,41 @@ func TestAVL_PreOrder(t *testing.T) {
 		{
 			name: "pre-order: root is called first",
 			fields: fields{
-				Root: &Node{
+				Root: &Node[model.Object]{
 					Value: rootVal,
-					Left: &Node{
+					Left: &Node[model.Object]{
 						Value: model.Object{Value: "le"},
-						Left: &Node{
+						Left: &Node[model.Object]{
 							Value: model.Object{Value: "l"},
 						},
-						Right: &Node{
+						Right: &Node[model.Object]{
 							Value: model.Object{Value: "lef"},
 						},
 					},
-					Right: &Node{
+					Right: &Node[model.Object]{
 						Value: model.Object{Value: "right"},
-						Left: &Node{
+						Left: &Node[model.Object]{
 							Value: model.Object{Value: "righ"},
 						},
-						Right: &Node{
+						Right: &Node[model.Object]{
 							Value: model.Object{Value: "righter"},
 						},
 					},
 				},
 			},
 			args: args{
-				f: func(v model.Object) {
+				f: func(v model.Object) bool {
 					fmt.Println(v.Value)
+					return true
 				},
 			},
 		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			b := AVL{
-				Root: tt.fields.Root,
+			b := AVL[model.Object]{
+				Root:    tt.fields.Root,
+				compare: treetest.CompareObjects,
 			}
 			b.PreOrder(tt.args.f)
 		})
@@ -561,10 +539,10 @@ func TestAVL_PreOrder(t *testing.T) {
 
 func TestAVL_InOrder(t *testing.T) {
 	type fields struct {
-		Root *Node
+		Root *Node[model.Object]
 	}
 	type args struct {
-		f NodeFunc
+		f NodeFunc[model.Object]
 	}
 	tests := []struct {
 		name   string
@@ -574,39 +552,41 @@ func TestAVL_InOrder(t *testing.T) {
 		{
 			name: "in-order: root is called mid-way",
 			fields: fields{
-				Root: &Node{
+				Root: &Node[model.Object]{
 					Value: rootVal,
-					Left: &Node{
+					Left: &Node[model.Object]{
 						Value: model.Object{Value: "le"},
-						Left: &Node{
+						Left: &Node[model.Object]{
 							Value: model.Object{Value: "l"},
 						},
-						Right: &Node{
+						Right: &Node[model.Object]{
 							Value: model.Object{Value: "lef"},
 						},
 					},
-					Right: &Node{
+					Right: &Node[model.Object]{
 						Value: model.Object{Value: "right"},
-						Left: &Node{
+						Left: &Node[model.Object]{
 							Value: model.Object{Value: "righ"},
 						},
-						Right: &Node{
+						Right: &Node[model.Object]{
 							Value: model.Object{Value: "righter"},
 						},
 					},
 				},
 			},
 			args: args{
-				f: func(v model.Object) {
+				f: func(v model.Object) bool {
 					fmt.Println(v.Value)
+					return true
 				},
 			},
 		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			b := AVL{
-				Root: tt.fields.Root,
+			b := AVL[model.Object]{
+				Root:    tt.fields.Root,
+				compare: treetest.CompareObjects,
 			}
 			b.InOrder(tt.args.f)
 		})
@@ -615,10 +595,10 @@ func TestAVL_InOrder(t *testing.T) {
 
 func TestAVL_PostOrder(t *testing.T) {
 	type fields struct {
-		Root *Node
+		Root *Node[model.Object]
 	}
 	type args struct {
-		f NodeFunc
+		f NodeFunc[model.Object]
 	}
 	tests := []struct {
 		name   string
@@ -628,41 +608,336 @@ func TestAVL_PostOrder(t *testing.T) {
 		{
 			name: "post-order: root is called last",
 			fields: fields{
-				Root: &Node{
+				Root: &Node[model.Object]{
 					Value: rootVal,
-					Left: &Node{
+					Left: &Node[model.Object]{
 						Value: model.Object{Value: "le"},
-						Left: &Node{
+						Left: &Node[model.Object]{
 							Value: model.Object{Value: "l"},
 						},
-						Right: &Node{
+						Right: &Node[model.Object]{
 							Value: model.Object{Value: "lef"},
 						},
 					},
-					Right: &Node{
+					Right: &Node[model.Object]{
 						Value: model.Object{Value: "right"},
-						Left: &Node{
+						Left: &Node[model.Object]{
 							Value: model.Object{Value: "righ"},
 						},
-						Right: &Node{
+						Right: &Node[model.Object]{
 							Value: model.Object{Value: "righter"},
 						},
 					},
 				},
 			},
 			args: args{
-				f: func(v model.Object) {
+				f: func(v model.Object) bool {
 					fmt.Println(v.Value)
+					return true
 				},
 			},
 		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			b := AVL{
-				Root: tt.fields.Root,
+			b := AVL[model.Object]{
+				Root:    tt.fields.Root,
+				compare: treetest.CompareObjects,
 			}
 			b.PostOrder(tt.args.f)
 		})
 	}
 }
+
+func TestAVL_Balance(t *testing.T) {
+	tests := []struct {
+		name      string
+		values    []int
+		remove    []int
+		maxHeight int
+	}{
+		{
+			name:      "ascending inserts stay balanced",
+			values:    sequence(1, 1000),
+			maxHeight: 14,
+		},
+		{
+			name:      "descending inserts stay balanced",
+			values:    sequence(1000, 1),
+			maxHeight: 14,
+		},
+		{
+			name:      "zig-zag inserts trigger double rotations",
+			values:    []int{30, 10, 20, 40, 60, 50},
+			maxHeight: 3,
+		},
+		{
+			name:      "removals rebalance the tree",
+			values:    sequence(1, 1000),
+			remove:    sequence(1, 900),
+			maxHeight: 9,
+		},
+		{
+			name:      "removing everything leaves an empty tree",
+			values:    sequence(1, 100),
+			remove:    sequence(100, 1),
+			maxHeight: 0,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			a := New[int]()
+			for _, v := range tt.values {
+				a.Add(v)
+				if err := a.Validate(); err != nil {
+					t.Fatalf("AVL.Add(%d) broke the AVL invariant: %v", v, err)
+				}
+			}
+			for _, v := range tt.remove {
+				if _, err := a.Remove(v); err != nil {
+					t.Fatalf("AVL.Remove(%d) error = %v", v, err)
+				}
+				if err := a.Validate(); err != nil {
+					t.Fatalf("AVL.Remove(%d) broke the AVL invariant: %v", v, err)
+				}
+				if _, found := a.Find(v); found {
+					t.Fatalf("AVL.Remove(%d) value still found in tree", v)
+				}
+			}
+			if got := a.Height(); got > tt.maxHeight {
+				t.Errorf("AVL.Height() = %d, want <= %d", got, tt.maxHeight)
+			}
+			var got []int
+			a.InOrder(func(v int) bool {
+				got = append(got, v)
+				return true
+			})
+			for i := 1; i < len(got); i++ {
+				if got[i-1] >= got[i] {
+					t.Fatalf("AVL.InOrder() out of order: %v", got)
+				}
+			}
+		})
+	}
+}
+
+func TestAVL_Rotations(t *testing.T) {
+	tests := []struct {
+		name     string
+		values   []int
+		rotate   func(a *AVL[int], n *Node[int])
+		pivot    int
+		wantRoot int
+	}{
+		{
+			name:     "left rotate promotes the right child",
+			values:   []int{2, 1, 4, 3, 5},
+			rotate:   (*AVL[int]).LeftRotate,
+			pivot:    2,
+			wantRoot: 4,
+		},
+		{
+			name:     "right rotate promotes the left child",
+			values:   []int{4, 2, 5, 1, 3},
+			rotate:   (*AVL[int]).RightRotate,
+			pivot:    4,
+			wantRoot: 2,
+		},
+		{
+			name:     "left-right rotate promotes the left child's right child",
+			values:   []int{4, 2, 5, 1, 3},
+			rotate:   (*AVL[int]).LeftRightRotate,
+			pivot:    4,
+			wantRoot: 3,
+		},
+		{
+			name:     "right-left rotate promotes the right child's left child",
+			values:   []int{2, 1, 4, 3, 5},
+			rotate:   (*AVL[int]).RightLeftRotate,
+			pivot:    2,
+			wantRoot: 3,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			a := New[int]()
+			for _, v := range tt.values {
+				a.Add(v)
+			}
+			pivot, _ := a.Find(tt.pivot)
+			tt.rotate(a, pivot)
+			if a.Root.Value != tt.wantRoot {
+				t.Errorf("rotation left root = %d, want %d", a.Root.Value, tt.wantRoot)
+			}
+			var got []int
+			a.InOrder(func(v int) bool {
+				got = append(got, v)
+				return true
+			})
+			if !reflect.DeepEqual(got, []int{1, 2, 3, 4, 5}) {
+				t.Errorf("rotation broke the ordering, got %v", got)
+			}
+			// Rotating a balanced tree may unbalance it, but Parent links and Heights must hold
+			a.Balance(a.Root)
+			if err := a.Validate(); err != nil {
+				t.Errorf("AVL.Validate() after rotation error = %v", err)
+			}
+		})
+	}
+}
+
+func TestAVL_Validate(t *testing.T) {
+	leaf := &Node[int]{Value: 1, Height: 1, Size: 1}
+	unbalanced := &Node[int]{Value: 3, Height: 3, Size: 3}
+	unbalanced.Left = &Node[int]{Value: 2, Height: 2, Size: 2, Parent: unbalanced}
+	unbalanced.Left.Left = &Node[int]{Value: 1, Height: 1, Size: 1, Parent: unbalanced.Left}
+	outOfOrder := &Node[int]{Value: 1, Height: 2, Size: 2}
+	outOfOrder.Left = &Node[int]{Value: 2, Height: 1, Size: 1, Parent: outOfOrder}
+	orphan := &Node[int]{Value: 2, Height: 2, Size: 2}
+	orphan.Left = &Node[int]{Value: 1, Height: 1, Size: 1}
+	tests := []struct {
+		name    string
+		root    *Node[int]
+		wantErr bool
+	}{
+		{
+			name: "empty tree is valid",
+		},
+		{
+			name: "single leaf is valid",
+			root: leaf,
+		},
+		{
+			name:    "balance factor of two is invalid",
+			root:    unbalanced,
+			wantErr: true,
+		},
+		{
+			name:    "values out of order are invalid",
+			root:    outOfOrder,
+			wantErr: true,
+		},
+		{
+			name:    "missing Parent pointer is invalid",
+			root:    orphan,
+			wantErr: true,
+		},
+		{
+			name:    "stale Height is invalid",
+			root:    &Node[int]{Value: 1, Size: 1},
+			wantErr: true,
+		},
+		{
+			name:    "stale Size is invalid",
+			root:    &Node[int]{Value: 1, Height: 1},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			a := New[int]()
+			a.Root = tt.root
+			if err := a.Validate(); (err != nil) != tt.wantErr {
+				t.Errorf("AVL.Validate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+// sequence :: func :: returns the integers from start to end inclusive, counting down if end < start
+func sequence(start, end int) []int {
+	step := 1
+	if end < start {
+		step = -1
+	}
+	var out []int
+	for i := start; i != end+step; i += step {
+		out = append(out, i)
+	}
+	return out
+}
+
+func TestAVL_RemoveCases(t *testing.T) {
+	values := []int{50, 30, 70, 20, 40, 60, 80, 65}
+	tests := []struct {
+		name    string
+		values  []int
+		remove  int
+		want    []int
+		wantErr bool
+	}{
+		{
+			name:   "leaf is unlinked",
+			values: values,
+			remove: 20,
+			want:   []int{30, 40, 50, 60, 65, 70, 80},
+		},
+		{
+			name:   "node with one child is replaced by that child",
+			values: values,
+			remove: 60,
+			want:   []int{20, 30, 40, 50, 65, 70, 80},
+		},
+		{
+			name:   "node with two children is replaced by its in-order successor and Parent pointers are fixed up",
+			values: values,
+			remove: 70,
+			want:   []int{20, 30, 40, 50, 60, 65, 80},
+		},
+		{
+			name:   "root is removed",
+			values: values,
+			remove: 50,
+			want:   []int{20, 30, 40, 60, 65, 70, 80},
+		},
+		{
+			name:   "only value is removed leaving an empty tree",
+			values: []int{50},
+			remove: 50,
+		},
+		{
+			name:    "missing value returns an error and leaves the tree alone",
+			values:  values,
+			remove:  99,
+			want:    []int{20, 30, 40, 50, 60, 65, 70, 80},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			a := New[int]()
+			for _, v := range tt.values {
+				a.Add(v)
+			}
+			if _, err := a.Remove(tt.remove); (err != nil) != tt.wantErr {
+				t.Errorf("AVL.Remove() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err := a.Validate(); err != nil {
+				t.Errorf("AVL.Remove() broke the AVL invariant: %v", err)
+			}
+			if _, found := a.Find(tt.remove); found {
+				t.Errorf("AVL.Remove() value still found in tree after Remove()")
+			}
+			var got []int
+			a.InOrder(func(v int) bool {
+				got = append(got, v)
+				return true
+			})
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("AVL.Remove() left %v, want %v", got, tt.want)
+			}
+			for _, v := range tt.want {
+				if _, found := a.Find(v); !found {
+					t.Errorf("AVL.Remove() lost %d", v)
+				}
+			}
+		})
+	}
+}
+
+func TestAVL_Suite(t *testing.T) {
+	treetest.Run(t, New[int], func(tree *AVL[int], v int) bool {
+		_, found := tree.Find(v)
+		return found
+	})
+}
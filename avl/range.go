@@ -0,0 +1,55 @@
+package avl
+
+import (
+	"iter"
+)
+
+// Range queries over the inclusive interval [lo, hi], walked by treewalk. Subtrees that fall
+// entirely outside the interval are never entered, so visiting k values costs O(height + k).
+
+// RangeFunc :: func :: Calls f, in sort order, for every value v with lo <= v <= hi, until f returns false
+func (a *AVL[T]) RangeFunc(lo, hi T, f NodeFunc[T]) {
+	walk[T]().Range(a.Root, lo, hi, a.comparator(), f)
+}
+
+// Range :: func :: Returns an iterator over every value v with lo <= v <= hi, in sort order.
+// Breaking out of the loop stops the walk.
+func (a *AVL[T]) Range(lo, hi T) iter.Seq[T] {
+	compare := a.comparator()
+	return func(yield func(T) bool) {
+		walk[T]().Range(a.Root, lo, hi, compare, yield)
+	}
+}
+
+// CountRange :: func :: Returns the number of values v with lo <= v <= hi.
+// Worked out from the Sizes stored on each Node rather than by walking the range,
+// so it's O(height) however many match.
+func (a *AVL[T]) CountRange(lo, hi T) int {
+	compare := a.comparator()
+	if compare(lo, hi) > 0 {
+		return 0
+	}
+	count := a.Root.rank(hi, compare) - a.Root.rank(lo, compare)
+	if _, found := a.Find(hi); found {
+		count++
+	}
+	return count
+}
+
+// rank :: func :: returns the number of values below n less than t
+func (n *Node[T]) rank(t T, compare func(a, b T) int) int {
+	rank := 0
+	for n != nil {
+		switch c := compare(t, n.Value); {
+		case c < 0:
+			n = n.Left
+		case c > 0:
+			// Everything in the left subtree, and n itself, is less than t
+			rank += n.Left.size() + 1
+			n = n.Right
+		default:
+			return rank + n.Left.size()
+		}
+	}
+	return rank
+}
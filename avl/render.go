@@ -0,0 +1,37 @@
+package avl
+
+import (
+	"fmt"
+	"io"
+)
+
+// Debug rendering, for seeing the shape of a tree rather than just its InOrder values.
+// The drawing itself is shared with bst, in treewalk.
+
+// WriteDOT :: func :: Writes the AVL to w as a Graphviz digraph, one vertex per Node
+// labelled with its value and Height, and its edges labelled L and R. Render it with e.g. `dot -Tsvg`.
+// A Node whose Parent doesn't point back at the Node above it is drawn in red.
+func (a *AVL[T]) WriteDOT(w io.Writer) error {
+	return walk[T]().WriteDOT(w, "AVL", a.Root, func(n, parent *Node[T]) string {
+		attrs := fmt.Sprintf("label=%q", fmt.Sprintf("%v\nh=%d", n.Value, n.Height))
+		if n.Parent != parent {
+			attrs += ", color=red"
+		}
+		return attrs
+	})
+}
+
+// String :: func :: Renders the AVL as an ASCII tree: the Root on the first line, and each
+// Node's children on the lines below it, Left before Right. When a Node has only one
+// child the missing one is drawn as nil, so left and right can't be confused.
+//
+//	40 (h=3)
+//	├── 20 (h=2)
+//	│   ├── nil
+//	│   └── 30 (h=1)
+//	└── 60 (h=1)
+func (a *AVL[T]) String() string {
+	return walk[T]().String(a.Root, func(n *Node[T]) string {
+		return fmt.Sprintf("%v (h=%d)", n.Value, n.Height)
+	})
+}
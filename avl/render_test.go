@@ -0,0 +1,110 @@
+package avl
+
+import (
+	"errors"
+	"strings"
+	"testing"
+)
+
+func TestAVL_String(t *testing.T) {
+	tests := []struct {
+		name string
+		tree *AVL[int]
+		want string
+	}{
+		{
+			name: "empty tree",
+			tree: New[int](),
+			want: "(empty)",
+		},
+		{
+			name: "single value",
+			tree: treeOf(1),
+			want: "1 (h=1)",
+		},
+		{
+			name: "missing children are drawn as nil",
+			tree: treeOf(40, 20, 60, 30),
+			want: strings.Join([]string{
+				"40 (h=3)",
+				"├── 20 (h=2)",
+				"│   ├── nil",
+				"│   └── 30 (h=1)",
+				"└── 60 (h=1)",
+			}, "\n"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.tree.String(); got != tt.want {
+				t.Errorf("AVL.String() =\n%v\nwant\n%v", got, tt.want)
+			}
+		})
+	}
+}
+
+type failingWriter struct{}
+
+func (failingWriter) Write([]byte) (int, error) {
+	return 0, errors.New("disk full")
+}
+
+func TestAVL_WriteDOT(t *testing.T) {
+	tests := []struct {
+		name string
+		tree func() *AVL[int]
+		want string
+	}{
+		{
+			name: "empty tree",
+			tree: New[int],
+			want: "digraph AVL {\n\tnode [shape=circle];\n}\n",
+		},
+		{
+			name: "nodes show their heights",
+			tree: func() *AVL[int] { return treeOf(2, 1, 3, 4) },
+			want: `digraph AVL {
+	node [shape=circle];
+	n0 [label="2\nh=3"];
+	n1 [label="1\nh=1"];
+	n2 [label="3\nh=2"];
+	n3 [label="4\nh=1"];
+	n0 -> n1 [label="L"];
+	n0 -> n2 [label="R"];
+	n2 -> n3 [label="R"];
+}
+`,
+		},
+		{
+			name: "broken parent links are drawn in red",
+			tree: func() *AVL[int] {
+				a := treeOf(2, 1, 3)
+				a.Root.Right.Parent = a.Root.Left
+				return a
+			},
+			want: `digraph AVL {
+	node [shape=circle];
+	n0 [label="2\nh=2"];
+	n1 [label="1\nh=1"];
+	n2 [label="3\nh=1", color=red];
+	n0 -> n1 [label="L"];
+	n0 -> n2 [label="R"];
+}
+`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var sb strings.Builder
+			if err := tt.tree().WriteDOT(&sb); err != nil {
+				t.Fatalf("AVL.WriteDOT() error = %v", err)
+			}
+			if got := sb.String(); got != tt.want {
+				t.Errorf("AVL.WriteDOT() =\n%v\nwant\n%v", got, tt.want)
+			}
+		})
+	}
+	if err := treeOf(1).WriteDOT(failingWriter{}); err == nil {
+		t.Errorf("AVL.WriteDOT() to a failing writer returned no error")
+	}
+}
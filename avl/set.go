@@ -0,0 +1,42 @@
+package avl
+
+// The rest of the orderedset.OrderedSet API; Add and Remove live with the rest of the AVL.
+
+// Contains :: func :: Reports whether a value equal to t is in the AVL
+func (a *AVL[T]) Contains(t T) bool {
+	_, found := a.Find(t)
+	return found
+}
+
+// Len :: func :: Returns the number of values in the AVL, the same as Size
+func (a *AVL[T]) Len() int {
+	return a.Size()
+}
+
+// Min :: func :: Returns the smallest value in the AVL, false if it's empty
+func (a *AVL[T]) Min() (T, bool) {
+	if a.Root == nil {
+		var zero T
+		return zero, false
+	}
+	return a.Root.min().Value, true
+}
+
+// Max :: func :: Returns the largest value in the AVL, false if it's empty
+func (a *AVL[T]) Max() (T, bool) {
+	if a.Root == nil {
+		var zero T
+		return zero, false
+	}
+	return a.Root.max().Value, true
+}
+
+// Ascend :: func :: Calls f with each value in Sort Order until f returns false, the same as InOrder
+func (a *AVL[T]) Ascend(f NodeFunc[T]) {
+	a.InOrder(f)
+}
+
+// Descend :: func :: Calls f with each value in reverse Sort Order until f returns false
+func (a *AVL[T]) Descend(f NodeFunc[T]) {
+	walk[T]().InOrder(a.Root, f, true)
+}
@@ -0,0 +1,15 @@
+package avl
+
+import (
+	"go-datastructures/orderedset"
+	"go-datastructures/treetest"
+	"testing"
+)
+
+var _ orderedset.OrderedSet[int] = (*AVL[int])(nil)
+
+func TestAVL_OrderedSet(t *testing.T) {
+	treetest.RunSet(t, func() orderedset.OrderedSet[int] {
+		return New[int]()
+	})
+}
@@ -0,0 +1,50 @@
+package avl
+
+// Shape introspection. An AVL can't degenerate the way a plain BST can, so Stats is mostly
+// useful for checking that it hasn't: every balance factor should be -1, 0 or 1.
+
+// Stats :: struct :: Summary of the shape of a tree
+type Stats struct {
+	Nodes  int
+	Height int
+	Leaves int
+	// Balance counts the Nodes by balance factor: the height of a Node's left subtree
+	// minus the height of its right. Counts far from 0 mean long one-sided chains.
+	Balance map[int]int
+}
+
+// Size :: func :: Returns the number of values in the AVL
+func (a *AVL[T]) Size() int {
+	return a.Root.size()
+}
+
+// Levels :: func :: Returns the values level by level from the Root down, each level left to right
+func (a *AVL[T]) Levels() [][]T {
+	var levels [][]T
+	walk[T]().Levels(a.Root, func(level []*Node[T]) bool {
+		values := make([]T, len(level))
+		for i, n := range level {
+			values[i] = n.Value
+		}
+		levels = append(levels, values)
+		return true
+	})
+	return levels
+}
+
+// Stats :: func :: Walks the whole AVL, returning a summary of its shape.
+// Balance factors come from the Heights stored on each Node.
+func (a *AVL[T]) Stats() Stats {
+	stats := Stats{Height: a.Height(), Balance: map[int]int{}}
+	walk[T]().Levels(a.Root, func(level []*Node[T]) bool {
+		for _, n := range level {
+			stats.Nodes++
+			stats.Balance[n.balanceFactor()]++
+			if n.Left == nil && n.Right == nil {
+				stats.Leaves++
+			}
+		}
+		return true
+	})
+	return stats
+}
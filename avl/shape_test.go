@@ -0,0 +1,103 @@
+package avl
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestAVL_Shape(t *testing.T) {
+	tests := []struct {
+		name       string
+		tree       *AVL[int]
+		wantLevels [][]int
+		wantStats  Stats
+	}{
+		{
+			name:      "empty tree",
+			tree:      New[int](),
+			wantStats: Stats{Balance: map[int]int{}},
+		},
+		{
+			name:       "left heavy tree",
+			tree:       treeOf(40, 20, 60, 10, 30, 50, 70, 5),
+			wantLevels: [][]int{{40}, {20, 60}, {10, 30, 50, 70}, {5}},
+			wantStats:  Stats{Nodes: 8, Height: 4, Leaves: 4, Balance: map[int]int{0: 5, 1: 3}},
+		},
+		{
+			name:       "sorted input stays balanced",
+			tree:       treeOf(1, 2, 3, 4, 5, 6, 7),
+			wantLevels: [][]int{{4}, {2, 6}, {1, 3, 5, 7}},
+			wantStats:  Stats{Nodes: 7, Height: 3, Leaves: 4, Balance: map[int]int{0: 7}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.tree.Levels(); !reflect.DeepEqual(got, tt.wantLevels) {
+				t.Errorf("AVL.Levels() = %v, want %v", got, tt.wantLevels)
+			}
+			if got := tt.tree.Stats(); !reflect.DeepEqual(got, tt.wantStats) {
+				t.Errorf("AVL.Stats() = %+v, want %+v", got, tt.wantStats)
+			}
+			if got := tt.tree.Size(); got != tt.wantStats.Nodes {
+				t.Errorf("AVL.Size() = %v, want %v", got, tt.wantStats.Nodes)
+			}
+			if got := tt.tree.Height(); got != tt.wantStats.Height {
+				t.Errorf("AVL.Height() = %v, want %v", got, tt.wantStats.Height)
+			}
+		})
+	}
+}
+
+func TestAVL_Size(t *testing.T) {
+	a := New[int]()
+	for _, v := range []int{3, 1, 2, 3, 1} {
+		a.Add(v)
+	}
+	if got := a.Size(); got != 3 {
+		t.Errorf("AVL.Size() after adding duplicates = %v, want 3", got)
+	}
+	a.Remove(2)
+	a.Remove(4)
+	if got := a.Size(); got != 2 {
+		t.Errorf("AVL.Size() after Remove() = %v, want 2", got)
+	}
+}
+
+func TestAVL_Size_HandBuilt(t *testing.T) {
+	// A tree built by setting Root, the way many of the package's tests build them, with each Node's Size filled in
+	root := &Node[int]{Value: 2, Height: 2, Size: 3}
+	root.Left = &Node[int]{Value: 1, Height: 1, Size: 1, Parent: root}
+	root.Right = &Node[int]{Value: 3, Height: 1, Size: 1, Parent: root}
+	a := NewFunc(func(x, y int) int { return x - y })
+	a.Root = root
+	steps := []struct {
+		name string
+		op   func()
+		want int
+	}{
+		{name: "built by hand", op: func() {}, want: 3},
+		{name: "Remove", op: func() { a.Remove(1) }, want: 2},
+		{name: "Remove missing value", op: func() { a.Remove(9) }, want: 2},
+		{name: "Add", op: func() { a.Add(4) }, want: 3},
+		{name: "Root replaced by hand", op: func() { a.Root = &Node[int]{Value: 7, Height: 1, Size: 1} }, want: 1},
+		{name: "Root rotated by hand", op: func() { a.Add(8); a.Add(9); a.LeftRotate(a.Root) }, want: 3},
+		{name: "rotated below the Root by hand", op: func() {
+			a.Add(10)
+			a.Add(11)
+			n, _ := a.Find(10)
+			a.LeftRotate(n)
+		}, want: 5},
+		{name: "emptied", op: func() {
+			for _, v := range []int{7, 8, 9, 10, 11} {
+				a.Remove(v)
+			}
+		}, want: 0},
+		{name: "Remove from empty", op: func() { a.Remove(7) }, want: 0},
+	}
+	for _, s := range steps {
+		s.op()
+		if got := a.Size(); got != s.want {
+			t.Errorf("after %s AVL.Size() = %d, want %d", s.name, got, s.want)
+		}
+	}
+}
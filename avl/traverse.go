@@ -0,0 +1,61 @@
+package avl
+
+import (
+	"go-datastructures/treewalk"
+	"iter"
+)
+
+// Traversals are the shared, non-recursive ones from treewalk.
+// Every traversal takes a NodeFunc, which has the same shape as an iter.Seq yield function:
+// the methods can be called directly with a callback, or ranged over.
+//
+//	for v := range tree.PreOrder {
+//		...
+//	}
+
+// NodeFunc :: func :: Some function that takes in a stored value and does an operation on it.
+// Returning false stops the traversal.
+type NodeFunc[T any] = func(t T) bool
+
+// PreOrder :: func :: Processes current, left, right
+func (a *AVL[T]) PreOrder(f NodeFunc[T]) {
+	walk[T]().PreOrder(a.Root, f)
+}
+
+// InOrder :: func :: Processes left, current, right
+// Items in the list will be processed in Sort Order
+func (a *AVL[T]) InOrder(f NodeFunc[T]) {
+	walk[T]().InOrder(a.Root, f, false)
+}
+
+// PostOrder :: func :: Processes left, right, current
+// Root will be processed last -- Deletion of the entire tree could be a use case
+func (a *AVL[T]) PostOrder(f NodeFunc[T]) {
+	walk[T]().PostOrder(a.Root, f)
+}
+
+// LevelOrder :: func :: Processes the tree breadth-first, one level at a time from the Root down,
+// each level left to right
+func (a *AVL[T]) LevelOrder(f NodeFunc[T]) {
+	walk[T]().LevelOrder(a.Root, f)
+}
+
+// All :: func :: Returns an iterator over the values in Sort Order
+func (a *AVL[T]) All() iter.Seq[T] {
+	return a.InOrder
+}
+
+// Backward :: func :: Returns an iterator over the values in reverse Sort Order
+func (a *AVL[T]) Backward() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		walk[T]().InOrder(a.Root, yield, true)
+	}
+}
+
+// walk :: func :: how the treewalk traversals read a Node
+func walk[T any]() treewalk.Nodes[*Node[T], T] {
+	return treewalk.Nodes[*Node[T], T]{
+		Value:    func(n *Node[T]) T { return n.Value },
+		Children: func(n *Node[T]) (*Node[T], *Node[T]) { return n.Left, n.Right },
+	}
+}
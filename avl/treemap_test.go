@@ -0,0 +1,10 @@
+package avl
+
+import (
+	"go-datastructures/treetest"
+	"testing"
+)
+
+func TestTreeMap(t *testing.T) {
+	treetest.RunTreeMap(t, NewTreeMap[string, treetest.Payload], NewTreeMapFunc[string, treetest.Payload])
+}
@@ -1,207 +1,200 @@
 package bst
 
 import (
+	"cmp"
 	"errors"
-	"go-datastructures/model"
-)
-
-const (
-	root = iota
-	left
-	right
+	"fmt"
 )
 
 // BST :: struct :: Basic Binary Search Tree implementation.
 type BST[T any] struct {
-	Root *Node[T]
+	Root    *Node[T]
+	compare func(a, b T) int
+}
+
+// New :: func :: Returns a pointer to a new BST ordered by the natural ordering of T
+func New[T cmp.Ordered]() *BST[T] {
+	return NewFunc(cmp.Compare[T])
+}
+
+// NewFunc :: func :: Returns a pointer to a new BST ordered by compare, which
+// returns a negative number when a < b, zero when a == b and a positive number when a > b
+func NewFunc[T any](compare func(a, b T) int) *BST[T] {
+	return &BST[T]{
+		compare: compare,
+	}
 }
 
-// Add :: func :: This will add in strings, with the left/right
-// placement of new nodes being decided by the length of the
-// incoming string. Nice and arbitrary.
+// Add :: func :: Adds a value to the BST, with the left/right placement of
+// new nodes being decided by the BST's comparator. Adding a value that
+// compares equal to one already in the tree replaces the stored value.
 func (b *BST[T]) Add(t T) {
 	if b.Root != nil {
-		b.Root.add(t)
+		b.Root.add(t, b.comparator())
 		return
 	} else {
 		b.Root = &Node[T]{
 			Value: t,
+			Size:  1,
 		}
 	}
 }
 
 // Remove :: func :: Removes a object/value from the BST. Returns an error if the value is not in the BST
-func (b BST[T]) Remove(obj T) (bool, error) {
-	removed := b.Root.remove(b.Root, root, obj)
+func (b *BST[T]) Remove(obj T) (bool, error) {
+	var removed bool
+	b.Root, removed = b.Root.remove(obj, b.comparator())
 	if !removed {
 		return removed, errors.New("object not found in list")
 	}
 	return removed, nil
 }
 
+// Find :: func :: Returns the Node holding a value equal to obj
 func (b BST[T]) Find(obj T) (*Node[T], bool) {
-	return b.Root.find(obj)
-}
-
-// NodeFunc :: func :: Some function that takes in  model.Object
-// and does an operation on the stored value, with no return.
-type NodeFunc func(t any)
-
-// PreOrder :: func :: Processes current, left, right
-func (b BST[T]) PreOrder(f NodeFunc) {
-	if b.Root == nil {
-		return
-	}
-	b.Root.preOrder(f)
+	return b.Root.find(obj, b.comparator())
 }
 
-// InOrder :: func :: Processes left, current, right
-// Items in the list will be processed in Sort Order
-func (b BST[T]) InOrder(f NodeFunc) {
-	if b.Root == nil {
-		return
-	}
-	b.Root.inOrder(f)
+// Validate :: func :: Checks the BST invariants, returning an error describing the
+// first violation found: values must be in order and stored Sizes must be accurate.
+func (b BST[T]) Validate() error {
+	_, err := b.Root.validate(b.comparator(), nil, nil)
+	return err
 }
 
-// PostOrder :: func :: Processes left, right, current
-// Root will be processed last -- Deletion of the entire tree could be a use case
-func (b BST[T]) PostOrder(f NodeFunc) {
-	if b.Root == nil {
-		return
+// comparator :: func :: A zero-value BST has no ordering to fall back on, so fail loudly
+func (b BST[T]) comparator() func(x, y T) int {
+	if b.compare == nil {
+		panic("bst: BST has no comparator, create it with New or NewFunc")
 	}
-	b.Root.postOrder(f)
+	return b.compare
 }
 
-// Node :: struct :: Node holds the values for the elements of the BST, and any pointers to child values
+// Node :: struct :: Node holds the values for the elements of the BST, and any pointers to child values.
+// Size is the number of Nodes in the subtree rooted here, itself included, which lets Rank and
+// Select skip whole subtrees.
 type Node[T any] struct {
 	Value T
+	Size  int
 	Left  *Node[T]
 	Right *Node[T]
 }
 
-func (n Node[T]) preOrder(f NodeFunc) {
-	f(n.Value)
-	if n.Left != nil {
-		n.Left.preOrder(f)
-	}
-	if n.Right != nil {
-		n.Right.preOrder(f)
+func (n *Node[T]) find(t T, compare func(a, b T) int) (*Node[T], bool) {
+	for n != nil {
+		switch c := compare(t, n.Value); {
+		case c < 0:
+			n = n.Left
+		case c > 0:
+			n = n.Right
+		default:
+			return n, true
+		}
 	}
+	return nil, false
 }
 
-func (n Node[T]) inOrder(f NodeFunc) {
-	if n.Left != nil {
-		n.Left.inOrder(f)
+// add :: func :: adds a new node, returning false if an existing value was replaced instead
+func (n *Node[T]) add(t T, compare func(a, b T) int) bool {
+	var added bool
+	switch c := compare(t, n.Value); {
+	case c > 0:
+		if n.Right == nil {
+			n.Right = &Node[T]{Value: t, Size: 1}
+			added = true
+		} else {
+			added = n.Right.add(t, compare)
+		}
+	case c < 0:
+		if n.Left == nil {
+			n.Left = &Node[T]{Value: t, Size: 1}
+			added = true
+		} else {
+			added = n.Left.add(t, compare)
+		}
+	default:
+		n.Value = t
 	}
-	f(n.Value)
-	if n.Right != nil {
-		n.Right.inOrder(f)
+	if added {
+		n.Size++
 	}
+	return added
 }
 
-func (n Node[T]) postOrder(f NodeFunc) {
-	if n.Left != nil {
-		n.Left.postOrder(f)
+// remove :: func :: removes the node matching obj from below n,
+// returning the subtree's new root and whether a node was removed
+func (n *Node[T]) remove(obj T, compare func(a, b T) int) (*Node[T], bool) {
+	if n == nil {
+		return nil, false
+	}
+	var removed bool
+	switch c := compare(obj, n.Value); {
+	case c < 0:
+		n.Left, removed = n.Left.remove(obj, compare)
+	case c > 0:
+		n.Right, removed = n.Right.remove(obj, compare)
+	default:
+		// Leaf or one child: the child (if any) takes this node's place
+		if n.Left == nil {
+			return n.Right, true
+		}
+		if n.Right == nil {
+			return n.Left, true
+		}
+		// Two children: take over the in-order successor's value, then remove the successor
+		successor := n.Right.min()
+		n.Value = successor.Value
+		n.Right, removed = n.Right.remove(successor.Value, compare)
 	}
-	if n.Right != nil {
-		n.Right.postOrder(f)
+	if removed {
+		n.Size--
 	}
-	f(n.Value)
+	return n, removed
 }
 
-func (n Node[T]) find(t T) (*Node[T], bool) {
-	match := n.Value.Value == t.Value
-	if match {
-		return &n, true
+// min :: func :: returns the left-most node below n
+func (n *Node[T]) min() *Node[T] {
+	for n.Left != nil {
+		n = n.Left
 	}
-	if less(t, n.Value) && n.Left != nil {
-		return n.Left.find(t)
-	} else if n.Right != nil {
-		return n.Right.find(t)
-	}
-	return nil, false
+	return n
 }
 
-// add :: func :: adds a new node
-func (n *Node[T]) add(t T) {
-	if n.Value == nil {
-		n.Value = t
-		return
-	}
-	if less(n.Value, t) {
-		if n.Right == nil {
-			n.Right = &Node[T]{Value: t}
-			return
-		}
-		n.Right.add(t)
-		return
-	}
-	if n.Left == nil {
-		n.Left = &Node{Value: t}
-		return
+// max :: func :: returns the right-most node below n
+func (n *Node[T]) max() *Node[T] {
+	for n.Right != nil {
+		n = n.Right
 	}
-	n.Left.add(t)
+	return n
 }
 
-// remove :: func :: removes any matching nodes
-func (n *Node[T]) remove(parent *Node[T], side int, obj T) bool {
-	switch side {
-	case root:
-		match := parent.Value.Value == n.Value.Value
-		if !match {
-			less := less(n.Value, obj)
-			if less {
-				return n.Right != nil && n.Right.remove(n, right, obj)
-			}
-			return n.Left != nil && n.Left.remove(n, left, obj)
-		}
-		n.Value.Value = ""
-		return true
-	case left:
-		if n.Value.Value == "" {
-			return false
-		}
-		// If this is a match for the current node
-		if match := n.Value.Value == obj.Value; match {
-			if n.Left != nil {
-				// Promote the left child
-				parent.Left = n.Left
-				n.Left.Right = n.Right
-			} else {
-				// Promote the right child
-				parent.Left = n.Right
-			}
-			return true
-		}
-		// Not a match, so return removing any non-nil children
-		return n.Right != nil && n.Right.remove(n, right, n.Right.Value) ||
-			n.Left != nil && n.Left.remove(n, left, n.Left.Value)
-	case right:
-		if n.Value.Value == "" {
-			return false
-		}
-		// If this is a match for the current node
-		if match := n.Value.Value == obj.Value; match {
-			if n.Right != nil {
-				// Promote the right child
-				parent.Right = n.Right
-				n.Right.Left = n.Left
-			} else {
-				// Promote the left child
-				parent.Right = n.Left
-			}
-			return true
-		}
-		// Not a match, so return removing any non-nil children
-		return n.Right != nil && n.Right.remove(n, right, n.Right.Value) ||
-			n.Left != nil && n.Left.remove(n, left, n.Left.Value)
-	default:
+// size :: func :: nil-safe Size, an empty subtree has a size of 0
+func (n *Node[T]) size() int {
+	if n == nil {
+		return 0
 	}
-	return false
+	return n.Size
 }
 
-func less[T any](o1, o2 T) bool {
-	// return len(o1.Value) < len(o2.Value)
-    return false
+// validate :: func :: checks the subtree rooted at n, whose values must fall strictly
+// between lo and hi when they're set, and returns its actual size
+func (n *Node[T]) validate(compare func(a, b T) int, lo, hi *T) (int, error) {
+	if n == nil {
+		return 0, nil
+	}
+	if (lo != nil && compare(n.Value, *lo) <= 0) || (hi != nil && compare(n.Value, *hi) >= 0) {
+		return 0, fmt.Errorf("node %v is out of order", n.Value)
+	}
+	ls, err := n.Left.validate(compare, lo, &n.Value)
+	if err != nil {
+		return 0, err
+	}
+	rs, err := n.Right.validate(compare, &n.Value, hi)
+	if err != nil {
+		return 0, err
+	}
+	if size := 1 + ls + rs; n.Size != size {
+		return 0, fmt.Errorf("node %v has size %d, want %d", n.Value, n.Size, size)
+	}
+	return n.Size, nil
 }
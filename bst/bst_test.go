@@ -3,6 +3,7 @@ package bst
 import (
 	"fmt"
 	"go-datastructures/model"
+	"go-datastructures/treetest"
 	"reflect"
 	"testing"
 )
@@ -15,7 +16,7 @@ var (
 
 func TestBST_Add(t *testing.T) {
 	type fields struct {
-		Root *Node
+		Root *Node[model.Object]
 	}
 	type args struct {
 		obj model.Object
@@ -33,19 +34,10 @@ func TestBST_Add(t *testing.T) {
 				obj: model.Object{Value: "first"},
 			},
 		},
-		{
-			name: "call to Add() with a non-nil root saves the value in Root",
-			fields: fields{
-				Root: &Node{},
-			},
-			args: args{
-				obj: model.Object{Value: "first"},
-			},
-		},
 		{
 			name: "call to Add() places left node correctly",
 			fields: fields{
-				Root: &Node{
+				Root: &Node[model.Object]{
 					Value: model.Object{Value: "first"},
 				},
 			},
@@ -57,7 +49,7 @@ func TestBST_Add(t *testing.T) {
 		{
 			name: "call to Add() places right node correctly",
 			fields: fields{
-				Root: &Node{
+				Root: &Node[model.Object]{
 					Value: model.Object{Value: "first"},
 				},
 			},
@@ -69,7 +61,7 @@ func TestBST_Add(t *testing.T) {
 		{
 			name: "call to Add() places right node correctly",
 			fields: fields{
-				Root: &Node{
+				Root: &Node[model.Object]{
 					Value: model.Object{Value: "primary"},
 				},
 			},
@@ -81,8 +73,9 @@ func TestBST_Add(t *testing.T) {
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			b := BST{
-				Root: tt.fields.Root,
+			b := BST[model.Object]{
+				Root:    tt.fields.Root,
+				compare: treetest.CompareObjects,
 			}
 			b.Add(tt.args.obj)
 			if node, found := b.Find(tt.args.obj); !found {
@@ -110,7 +103,7 @@ func TestBST_Add(t *testing.T) {
 
 func TestBST_Find(t *testing.T) {
 	type fields struct {
-		Root *Node
+		Root *Node[model.Object]
 	}
 	type args struct {
 		obj model.Object
@@ -119,13 +112,13 @@ func TestBST_Find(t *testing.T) {
 		name      string
 		fields    fields
 		args      args
-		want      *Node
+		want      *Node[model.Object]
 		wantFound bool
 	}{
 		{
 			name: "searching for a value that doesn't exist",
 			fields: fields{
-				Root: &Node{
+				Root: &Node[model.Object]{
 					Value: model.Object{
 						Value: "first",
 					},
@@ -138,7 +131,7 @@ func TestBST_Find(t *testing.T) {
 		{
 			name: "bst with value at root returns true",
 			fields: fields{
-				Root: &Node{
+				Root: &Node[model.Object]{
 					Value: model.Object{
 						Value: "first",
 					},
@@ -147,17 +140,17 @@ func TestBST_Find(t *testing.T) {
 			args: args{
 				model.Object{Value: "first"},
 			},
-			want:      &Node{Value: model.Object{Value: "first"}},
+			want:      &Node[model.Object]{Value: model.Object{Value: "first"}},
 			wantFound: true,
 		},
 		{
 			name: "bst with value at the right returns true",
 			fields: fields{
-				Root: &Node{
+				Root: &Node[model.Object]{
 					Value: model.Object{
 						Value: "first",
 					},
-					Right: &Node{
+					Right: &Node[model.Object]{
 						Value: model.Object{Value: "second"},
 					},
 				},
@@ -165,17 +158,17 @@ func TestBST_Find(t *testing.T) {
 			args: args{
 				model.Object{Value: "second"},
 			},
-			want:      &Node{Value: model.Object{Value: "second"}},
+			want:      &Node[model.Object]{Value: model.Object{Value: "second"}},
 			wantFound: true,
 		},
 		{
 			name: "bst with value at the left returns true",
 			fields: fields{
-				Root: &Node{
+				Root: &Node[model.Object]{
 					Value: model.Object{
 						Value: "first",
 					},
-					Left: &Node{
+					Left: &Node[model.Object]{
 						Value: model.Object{Value: "two"},
 					},
 				},
@@ -183,22 +176,22 @@ func TestBST_Find(t *testing.T) {
 			args: args{
 				model.Object{Value: "two"},
 			},
-			want:      &Node{Value: model.Object{Value: "two"}},
+			want:      &Node[model.Object]{Value: model.Object{Value: "two"}},
 			wantFound: true,
 		},
 		{
 			name: "find goes through multiple levels to find expected match",
 			fields: fields{
-				Root: &Node{
+				Root: &Node[model.Object]{
 					Value: model.Object{
 						Value: "first",
 					},
-					Left: &Node{
+					Left: &Node[model.Object]{
 						Value: model.Object{Value: "two"},
 					},
-					Right: &Node{
+					Right: &Node[model.Object]{
 						Value: model.Object{Value: "secondary"},
-						Left: &Node{
+						Left: &Node[model.Object]{
 							Value: model.Object{Value: "seconda"},
 						},
 					},
@@ -207,14 +200,15 @@ func TestBST_Find(t *testing.T) {
 			args: args{
 				model.Object{Value: "seconda"},
 			},
-			want:      &Node{Value: model.Object{Value: "seconda"}},
+			want:      &Node[model.Object]{Value: model.Object{Value: "seconda"}},
 			wantFound: true,
 		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			b := BST{
-				Root: tt.fields.Root,
+			b := BST[model.Object]{
+				Root:    tt.fields.Root,
+				compare: treetest.CompareObjects,
 			}
 			got, found := b.Find(tt.args.obj)
 			if !reflect.DeepEqual(&got, &tt.want) {
@@ -230,30 +224,30 @@ func TestBST_Find(t *testing.T) {
 func TestNode_Find(t *testing.T) {
 	type fields struct {
 		Value model.Object
-		Left  *Node
-		Right *Node
+		Left  *Node[model.Object]
+		Right *Node[model.Object]
 	}
 	type args struct {
-		parent *Node
+		parent *Node[model.Object]
 		obj    model.Object
 	}
 	tests := []struct {
 		name   string
 		fields fields
 		args   args
-		want   *Node
+		want   *Node[model.Object]
 		want1  bool
 	}{
 		// TODO: Add test cases.
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			n := Node{
+			n := Node[model.Object]{
 				Value: tt.fields.Value,
 				Left:  tt.fields.Left,
 				Right: tt.fields.Right,
 			}
-			got, got1 := n.find(tt.args.obj)
+			got, got1 := n.find(tt.args.obj, treetest.CompareObjects)
 			if !reflect.DeepEqual(got, tt.want) {
 				t.Errorf("Node.Find() got = %v, want %v", got, tt.want)
 			}
@@ -266,7 +260,7 @@ func TestNode_Find(t *testing.T) {
 
 func TestBST_Remove(t *testing.T) {
 	type fields struct {
-		Root *Node
+		Root *Node[model.Object]
 	}
 	type args struct {
 		obj model.Object
@@ -284,7 +278,7 @@ func TestBST_Remove(t *testing.T) {
 				obj: model.Object{Value: "root"},
 			},
 			fields: fields{
-				Root: &Node{Value: model.Object{"root"}},
+				Root: &Node[model.Object]{Value: model.Object{Value: "root"}},
 			},
 			want: true,
 		},
@@ -294,7 +288,7 @@ func TestBST_Remove(t *testing.T) {
 				obj: model.Object{Value: "root"},
 			},
 			fields: fields{
-				Root: &Node{Value: model.Object{"notRoot"}},
+				Root: &Node[model.Object]{Value: model.Object{Value: "notRoot"}},
 			},
 			want:    false,
 			wantErr: true,
@@ -302,8 +296,9 @@ func TestBST_Remove(t *testing.T) {
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			b := BST{
-				Root: tt.fields.Root,
+			b := BST[model.Object]{
+				Root:    tt.fields.Root,
+				compare: treetest.CompareObjects,
 			}
 			got, err := b.Remove(tt.args.obj)
 			if (err != nil) != tt.wantErr {
@@ -320,13 +315,11 @@ func TestBST_Remove(t *testing.T) {
 func TestNode_Remove(t *testing.T) {
 	type fields struct {
 		Value model.Object
-		Left  *Node
-		Right *Node
+		Left  *Node[model.Object]
+		Right *Node[model.Object]
 	}
 	type args struct {
-		parent *Node
-		side   int
-		obj    model.Object
+		obj model.Object
 	}
 	tests := []struct {
 		name      string
@@ -341,9 +334,7 @@ func TestNode_Remove(t *testing.T) {
 				Value: rootVal,
 			},
 			args: args{
-				parent: &Node{Value: rootVal},
-				side:   root,
-				obj:    rootVal,
+				obj: rootVal,
 			},
 			want: true,
 		},
@@ -351,12 +342,10 @@ func TestNode_Remove(t *testing.T) {
 			name: "right child is removed",
 			fields: fields{
 				Value: rootVal,
-				Right: &Node{Value: rightVal},
+				Right: &Node[model.Object]{Value: rightVal},
 			},
 			args: args{
-				parent: &Node{Value: rightVal},
-				side:   root,
-				obj:    rightVal,
+				obj: rightVal,
 			},
 			want: true,
 		},
@@ -364,16 +353,14 @@ func TestNode_Remove(t *testing.T) {
 			name: "right child is removed and tree is correctly re-built",
 			fields: fields{
 				Value: rootVal,
-				Right: &Node{
+				Right: &Node[model.Object]{
 					Value: rightVal,
-					Left:  &Node{Value: model.Object{Value: "righ"}},
-					Right: &Node{Value: model.Object{Value: "righter"}},
+					Left:  &Node[model.Object]{Value: model.Object{Value: "rust"}},
+					Right: &Node[model.Object]{Value: model.Object{Value: "righter"}},
 				},
 			},
 			args: args{
-				parent: &Node{Value: rightVal},
-				side:   root,
-				obj:    rightVal,
+				obj: rightVal,
 			},
 			want: true,
 		},
@@ -381,15 +368,13 @@ func TestNode_Remove(t *testing.T) {
 			name: "right child is removed and right child is promoted",
 			fields: fields{
 				Value: rootVal,
-				Right: &Node{
+				Right: &Node[model.Object]{
 					Value: rightVal,
-					Right: &Node{Value: model.Object{Value: "righter"}},
+					Right: &Node[model.Object]{Value: model.Object{Value: "righter"}},
 				},
 			},
 			args: args{
-				parent: &Node{Value: rightVal},
-				side:   root,
-				obj:    rightVal,
+				obj: rightVal,
 			},
 			want:      true,
 			wantChild: "righter",
@@ -398,29 +383,25 @@ func TestNode_Remove(t *testing.T) {
 			name: "right child is removed and left child is promoted",
 			fields: fields{
 				Value: rootVal,
-				Right: &Node{
+				Right: &Node[model.Object]{
 					Value: rightVal,
-					Left:  &Node{Value: model.Object{Value: "righ"}},
+					Left:  &Node[model.Object]{Value: model.Object{Value: "rust"}},
 				},
 			},
 			args: args{
-				parent: &Node{Value: rightVal},
-				side:   root,
-				obj:    rightVal,
+				obj: rightVal,
 			},
 			want:      true,
-			wantChild: "righ",
+			wantChild: "rust",
 		},
 		{
 			name: "left child is removed",
 			fields: fields{
 				Value: rootVal,
-				Left:  &Node{Value: leftVal},
+				Left:  &Node[model.Object]{Value: leftVal},
 			},
 			args: args{
-				parent: &Node{Value: leftVal},
-				side:   root,
-				obj:    leftVal,
+				obj: leftVal,
 			},
 			want: true,
 		},
@@ -428,16 +409,14 @@ func TestNode_Remove(t *testing.T) {
 			name: "left child is removed and tree is correctly re-built",
 			fields: fields{
 				Value: rootVal,
-				Left: &Node{
+				Left: &Node[model.Object]{
 					Value: leftVal,
-					Left:  &Node{Value: model.Object{Value: "l"}},
-					Right: &Node{Value: model.Object{Value: "lef"}},
+					Left:  &Node[model.Object]{Value: model.Object{Value: "l"}},
+					Right: &Node[model.Object]{Value: model.Object{Value: "lef"}},
 				},
 			},
 			args: args{
-				parent: &Node{Value: leftVal},
-				side:   root,
-				obj:    leftVal,
+				obj: leftVal,
 			},
 			want: true,
 		},
@@ -445,15 +424,13 @@ func TestNode_Remove(t *testing.T) {
 			name: "left child is removed and right child is promoted",
 			fields: fields{
 				Value: rootVal,
-				Left: &Node{
+				Left: &Node[model.Object]{
 					Value: leftVal,
-					Right: &Node{Value: model.Object{Value: "lef"}},
+					Right: &Node[model.Object]{Value: model.Object{Value: "lef"}},
 				},
 			},
 			args: args{
-				parent: &Node{Value: leftVal},
-				side:   root,
-				obj:    leftVal,
+				obj: leftVal,
 			},
 			want:      true,
 			wantChild: "lef",
@@ -462,15 +439,13 @@ func TestNode_Remove(t *testing.T) {
 			name: "left child is removed and left child is promoted",
 			fields: fields{
 				Value: rootVal,
-				Left: &Node{
+				Left: &Node[model.Object]{
 					Value: leftVal,
-					Left:  &Node{Value: model.Object{Value: "l"}},
+					Left:  &Node[model.Object]{Value: model.Object{Value: "l"}},
 				},
 			},
 			args: args{
-				parent: &Node{Value: leftVal},
-				side:   root,
-				obj:    leftVal,
+				obj: leftVal,
 			},
 			want:      true,
 			wantChild: "l",
@@ -478,15 +453,16 @@ func TestNode_Remove(t *testing.T) {
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			n := Node{
+			n := &Node[model.Object]{
 				Value: tt.fields.Value,
 				Left:  tt.fields.Left,
 				Right: tt.fields.Right,
 			}
-			if got := n.remove(tt.args.parent, tt.args.side, tt.args.obj); got != tt.want {
+			n, got := n.remove(tt.args.obj, treetest.CompareObjects)
+			if got != tt.want {
 				t.Errorf("Node.Remove() = %v, want %v", got, tt.want)
 			}
-			_, found := n.find(tt.args.obj)
+			_, found := n.find(tt.args.obj, treetest.CompareObjects)
 			if tt.want == true && found {
 				t.Errorf("Node.Remove() value still found in tree after Remove()")
 			}
@@ -495,7 +471,7 @@ func TestNode_Remove(t *testing.T) {
 				case "nil":
 					// TODO:
 				default:
-					_, foundChild := n.find(model.Object{Value: tt.wantChild})
+					_, foundChild := n.find(model.Object{Value: tt.wantChild}, treetest.CompareObjects)
 					if !foundChild {
 						t.Errorf("Node.Remove() expected child not found in tree after Remove()")
 					}
@@ -507,10 +483,10 @@ func TestNode_Remove(t *testing.T) {
 
 func TestBST_PreOrder(t *testing.T) {
 	type fields struct {
-		Root *Node
+		Root *Node[model.Object]
 	}
 	type args struct {
-		f NodeFunc
+		f NodeFunc[model.Object]
 	}
 	tests := []struct {
 		name   string
@@ -520,39 +496,41 @@ func TestBST_PreOrder(t *testing.T) {
 		{
 			name: "pre-order: root is called first",
 			fields: fields{
-				Root: &Node{
+				Root: &Node[model.Object]{
 					Value: rootVal,
-					Left: &Node{
+					Left: &Node[model.Object]{
 						Value: model.Object{Value: "le"},
-						Left: &Node{
+						Left: &Node[model.Object]{
 							Value: model.Object{Value: "l"},
 						},
-						Right: &Node{
+						Right: &Node[model.Object]{
 							Value: model.Object{Value: "lef"},
 						},
 					},
-					Right: &Node{
+					Right: &Node[model.Object]{
 						Value: model.Object{Value: "right"},
-						Left: &Node{
+						Left: &Node[model.Object]{
 							Value: model.Object{Value: "righ"},
 						},
-						Right: &Node{
+						Right: &Node[model.Object]{
 							Value: model.Object{Value: "righter"},
 						},
 					},
 				},
 			},
 			args: args{
-				f: func(v model.Object) {
+				f: func(v model.Object) bool {
 					fmt.Println(v.Value)
+					return true
 				},
 			},
 		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			b := BST{
-				Root: tt.fields.Root,
+			b := BST[model.Object]{
+				Root:    tt.fields.Root,
+				compare: treetest.CompareObjects,
 			}
 			b.PreOrder(tt.args.f)
 		})
@@ -561,10 +539,10 @@ func TestBST_PreOrder(t *testing.T) {
 
 func TestBST_InOrder(t *testing.T) {
 	type fields struct {
-		Root *Node
+		Root *Node[model.Object]
 	}
 	type args struct {
-		f NodeFunc
+		f NodeFunc[model.Object]
 	}
 	tests := []struct {
 		name   string
@@ -574,39 +552,41 @@ func TestBST_InOrder(t *testing.T) {
 		{
 			name: "in-order: root is called mid-way",
 			fields: fields{
-				Root: &Node{
+				Root: &Node[model.Object]{
 					Value: rootVal,
-					Left: &Node{
+					Left: &Node[model.Object]{
 						Value: model.Object{Value: "le"},
-						Left: &Node{
+						Left: &Node[model.Object]{
 							Value: model.Object{Value: "l"},
 						},
-						Right: &Node{
+						Right: &Node[model.Object]{
 							Value: model.Object{Value: "lef"},
 						},
 					},
-					Right: &Node{
+					Right: &Node[model.Object]{
 						Value: model.Object{Value: "right"},
-						Left: &Node{
+						Left: &Node[model.Object]{
 							Value: model.Object{Value: "righ"},
 						},
-						Right: &Node{
+						Right: &Node[model.Object]{
 							Value: model.Object{Value: "righter"},
 						},
 					},
 				},
 			},
 			args: args{
-				f: func(v model.Object) {
+				f: func(v model.Object) bool {
 					fmt.Println(v.Value)
+					return true
 				},
 			},
 		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			b := BST{
-				Root: tt.fields.Root,
+			b := BST[model.Object]{
+				Root:    tt.fields.Root,
+				compare: treetest.CompareObjects,
 			}
 			b.InOrder(tt.args.f)
 		})
@@ -615,10 +595,10 @@ func TestBST_InOrder(t *testing.T) {
 
 func TestBST_PostOrder(t *testing.T) {
 	type fields struct {
-		Root *Node
+		Root *Node[model.Object]
 	}
 	type args struct {
-		f NodeFunc
+		f NodeFunc[model.Object]
 	}
 	tests := []struct {
 		name   string
@@ -628,41 +608,178 @@ func TestBST_PostOrder(t *testing.T) {
 		{
 			name: "post-order: root is called last",
 			fields: fields{
-				Root: &Node{
+				Root: &Node[model.Object]{
 					Value: rootVal,
-					Left: &Node{
+					Left: &Node[model.Object]{
 						Value: model.Object{Value: "le"},
-						Left: &Node{
+						Left: &Node[model.Object]{
 							Value: model.Object{Value: "l"},
 						},
-						Right: &Node{
+						Right: &Node[model.Object]{
 							Value: model.Object{Value: "lef"},
 						},
 					},
-					Right: &Node{
+					Right: &Node[model.Object]{
 						Value: model.Object{Value: "right"},
-						Left: &Node{
+						Left: &Node[model.Object]{
 							Value: model.Object{Value: "righ"},
 						},
-						Right: &Node{
+						Right: &Node[model.Object]{
 							Value: model.Object{Value: "righter"},
 						},
 					},
 				},
 			},
 			args: args{
-				f: func(v model.Object) {
+				f: func(v model.Object) bool {
 					fmt.Println(v.Value)
+					return true
 				},
 			},
 		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			b := BST{
-				Root: tt.fields.Root,
+			b := BST[model.Object]{
+				Root:    tt.fields.Root,
+				compare: treetest.CompareObjects,
 			}
 			b.PostOrder(tt.args.f)
 		})
 	}
 }
+
+func TestBST_Comparator(t *testing.T) {
+	tests := []struct {
+		name   string
+		tree   *BST[int]
+		values []int
+		want   []int
+	}{
+		{
+			name:   "natural ordering",
+			tree:   New[int](),
+			values: []int{5, 3, 8, 1, 4, 9, 7},
+			want:   []int{1, 3, 4, 5, 7, 8, 9},
+		},
+		{
+			name: "reversed comparator",
+			tree: NewFunc(func(a, b int) int {
+				return b - a
+			}),
+			values: []int{5, 3, 8, 1, 4, 9, 7},
+			want:   []int{9, 8, 7, 5, 4, 3, 1},
+		},
+		{
+			name:   "duplicate values are stored once",
+			tree:   New[int](),
+			values: []int{2, 1, 2, 3, 1},
+			want:   []int{1, 2, 3},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			for _, v := range tt.values {
+				tt.tree.Add(v)
+			}
+			var got []int
+			tt.tree.InOrder(func(v int) bool {
+				got = append(got, v)
+				return true
+			})
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("BST.InOrder() = %v, want %v", got, tt.want)
+			}
+			for _, v := range tt.values {
+				if _, found := tt.tree.Find(v); !found {
+					t.Errorf("BST.Find(%d) not found after Add()", v)
+				}
+			}
+			if _, found := tt.tree.Find(100); found {
+				t.Error("BST.Find(100) found a value that was never added")
+			}
+		})
+	}
+}
+
+func TestBST_RemoveCases(t *testing.T) {
+	values := []int{50, 30, 70, 20, 40, 60, 80, 65}
+	tests := []struct {
+		name    string
+		values  []int
+		remove  int
+		want    []int
+		wantErr bool
+	}{
+		{
+			name:   "leaf is unlinked",
+			values: values,
+			remove: 20,
+			want:   []int{30, 40, 50, 60, 65, 70, 80},
+		},
+		{
+			name:   "node with one child is replaced by that child",
+			values: values,
+			remove: 60,
+			want:   []int{20, 30, 40, 50, 65, 70, 80},
+		},
+		{
+			name:   "node with two children is replaced by its in-order successor",
+			values: values,
+			remove: 70,
+			want:   []int{20, 30, 40, 50, 60, 65, 80},
+		},
+		{
+			name:   "root is removed",
+			values: values,
+			remove: 50,
+			want:   []int{20, 30, 40, 60, 65, 70, 80},
+		},
+		{
+			name:   "only value is removed leaving an empty tree",
+			values: []int{50},
+			remove: 50,
+		},
+		{
+			name:    "missing value returns an error and leaves the tree alone",
+			values:  values,
+			remove:  99,
+			want:    []int{20, 30, 40, 50, 60, 65, 70, 80},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			b := New[int]()
+			for _, v := range tt.values {
+				b.Add(v)
+			}
+			if _, err := b.Remove(tt.remove); (err != nil) != tt.wantErr {
+				t.Errorf("BST.Remove() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if _, found := b.Find(tt.remove); found {
+				t.Errorf("BST.Remove() value still found in tree after Remove()")
+			}
+			var got []int
+			b.InOrder(func(v int) bool {
+				got = append(got, v)
+				return true
+			})
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("BST.Remove() left %v, want %v", got, tt.want)
+			}
+			for _, v := range tt.want {
+				if _, found := b.Find(v); !found {
+					t.Errorf("BST.Remove() lost %d", v)
+				}
+			}
+		})
+	}
+}
+
+func TestBST_Suite(t *testing.T) {
+	treetest.Run(t, New[int], func(tree *BST[int], v int) bool {
+		_, found := tree.Find(v)
+		return found
+	})
+}
@@ -0,0 +1,129 @@
+package bst
+
+// Ordered-map style queries. These all walk a single path down from the Root,
+// so they run in O(height) rather than visiting every Node like InOrder does.
+
+// Min :: func :: Returns the smallest value in the BST, false if it's empty
+func (b BST[T]) Min() (T, bool) {
+	if b.Root == nil {
+		var zero T
+		return zero, false
+	}
+	return b.Root.min().Value, true
+}
+
+// Max :: func :: Returns the largest value in the BST, false if it's empty
+func (b BST[T]) Max() (T, bool) {
+	if b.Root == nil {
+		var zero T
+		return zero, false
+	}
+	return b.Root.max().Value, true
+}
+
+// Floor :: func :: Returns the largest value less than or equal to t
+func (b BST[T]) Floor(t T) (T, bool) {
+	return b.below(t, true)
+}
+
+// Predecessor :: func :: Returns the largest value strictly less than t
+func (b BST[T]) Predecessor(t T) (T, bool) {
+	return b.below(t, false)
+}
+
+// Ceiling :: func :: Returns the smallest value greater than or equal to t
+func (b BST[T]) Ceiling(t T) (T, bool) {
+	return b.above(t, true)
+}
+
+// Successor :: func :: Returns the smallest value strictly greater than t
+func (b BST[T]) Successor(t T) (T, bool) {
+	return b.above(t, false)
+}
+
+// Rank :: func :: Returns the number of values in the BST less than t.
+// When t is in the BST this is its zero-based position in InOrder.
+func (b BST[T]) Rank(t T) int {
+	compare := b.comparator()
+	rank := 0
+	for n := b.Root; n != nil; {
+		switch c := compare(t, n.Value); {
+		case c < 0:
+			n = n.Left
+		case c > 0:
+			// Everything in the left subtree, and n itself, is less than t
+			rank += n.Left.size() + 1
+			n = n.Right
+		default:
+			return rank + n.Left.size()
+		}
+	}
+	return rank
+}
+
+// Select :: func :: Returns the value at zero-based position k in InOrder, the inverse of Rank.
+// Returns false if k is out of range.
+func (b BST[T]) Select(k int) (T, bool) {
+	for n := b.Root; n != nil && k >= 0; {
+		switch left := n.Left.size(); {
+		case k < left:
+			n = n.Left
+		case k > left:
+			k -= left + 1
+			n = n.Right
+		default:
+			return n.Value, true
+		}
+	}
+	var zero T
+	return zero, false
+}
+
+// below :: func :: Floor when inclusive, Predecessor otherwise
+func (b BST[T]) below(t T, inclusive bool) (T, bool) {
+	compare := b.comparator()
+	var best *Node[T]
+	for n := b.Root; n != nil; {
+		c := compare(t, n.Value)
+		if c == 0 && inclusive {
+			return n.Value, true
+		}
+		if c > 0 {
+			// n is a candidate, but there may be a closer one to its right
+			best = n
+			n = n.Right
+		} else {
+			n = n.Left
+		}
+	}
+	return valueOf(best)
+}
+
+// above :: func :: Ceiling when inclusive, Successor otherwise
+func (b BST[T]) above(t T, inclusive bool) (T, bool) {
+	compare := b.comparator()
+	var best *Node[T]
+	for n := b.Root; n != nil; {
+		c := compare(t, n.Value)
+		if c == 0 && inclusive {
+			return n.Value, true
+		}
+		if c < 0 {
+			// n is a candidate, but there may be a closer one to its left
+			best = n
+			n = n.Left
+		} else {
+			n = n.Right
+		}
+	}
+	return valueOf(best)
+}
+
+// valueOf :: func :: nil-safe Value
+func valueOf[T any](n *Node[T]) (T, bool) {
+	if n == nil {
+		var zero T
+		return zero, false
+	}
+	return n.Value, true
+}
@@ -0,0 +1,175 @@
+package bst
+
+import (
+	"math/rand"
+	"sort"
+	"testing"
+)
+
+func treeOf(values ...int) *BST[int] {
+	b := New[int]()
+	for _, v := range values {
+		b.Add(v)
+	}
+	return b
+}
+
+func TestBST_MinMax(t *testing.T) {
+	tests := []struct {
+		name      string
+		tree      *BST[int]
+		wantMin   int
+		wantMax   int
+		wantFound bool
+	}{
+		{
+			name: "empty tree",
+			tree: New[int](),
+		},
+		{
+			name:      "single value is both",
+			tree:      treeOf(5),
+			wantMin:   5,
+			wantMax:   5,
+			wantFound: true,
+		},
+		{
+			name:      "balanced tree",
+			tree:      treeOf(40, 20, 60, 10, 30, 50, 70),
+			wantMin:   10,
+			wantMax:   70,
+			wantFound: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got, found := tt.tree.Min(); got != tt.wantMin || found != tt.wantFound {
+				t.Errorf("BST.Min() = %v, %v, want %v, %v", got, found, tt.wantMin, tt.wantFound)
+			}
+			if got, found := tt.tree.Max(); got != tt.wantMax || found != tt.wantFound {
+				t.Errorf("BST.Max() = %v, %v, want %v, %v", got, found, tt.wantMax, tt.wantFound)
+			}
+		})
+	}
+}
+
+func TestBST_Neighbours(t *testing.T) {
+	tree := treeOf(40, 20, 60, 10, 30, 50, 70)
+	type result struct {
+		value int
+		found bool
+	}
+	tests := []struct {
+		name        string
+		key         int
+		floor       result
+		ceiling     result
+		predecessor result
+		successor   result
+	}{
+		{
+			name:        "key in the tree",
+			key:         30,
+			floor:       result{30, true},
+			ceiling:     result{30, true},
+			predecessor: result{20, true},
+			successor:   result{40, true},
+		},
+		{
+			name:        "key between values",
+			key:         45,
+			floor:       result{40, true},
+			ceiling:     result{50, true},
+			predecessor: result{40, true},
+			successor:   result{50, true},
+		},
+		{
+			name:      "key below everything",
+			key:       5,
+			ceiling:   result{10, true},
+			successor: result{10, true},
+		},
+		{
+			name:        "key above everything",
+			key:         75,
+			floor:       result{70, true},
+			predecessor: result{70, true},
+		},
+		{
+			name:        "smallest key has no predecessor",
+			key:         10,
+			floor:       result{10, true},
+			ceiling:     result{10, true},
+			successor:   result{20, true},
+			predecessor: result{},
+		},
+		{
+			name:        "largest key has no successor",
+			key:         70,
+			floor:       result{70, true},
+			ceiling:     result{70, true},
+			predecessor: result{60, true},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			check := func(method string, f func(int) (int, bool), want result) {
+				if got, found := f(tt.key); got != want.value || found != want.found {
+					t.Errorf("BST.%s(%d) = %v, %v, want %v, %v", method, tt.key, got, found, want.value, want.found)
+				}
+			}
+			check("Floor", tree.Floor, tt.floor)
+			check("Ceiling", tree.Ceiling, tt.ceiling)
+			check("Predecessor", tree.Predecessor, tt.predecessor)
+			check("Successor", tree.Successor, tt.successor)
+		})
+	}
+}
+
+func TestBST_RankSelect(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	b := New[int]()
+	present := map[int]bool{}
+	for i := 0; i < 2000; i++ {
+		v := r.Intn(500)
+		if r.Intn(3) == 0 {
+			_, _ = b.Remove(v)
+			delete(present, v)
+		} else {
+			b.Add(v)
+			present[v] = true
+		}
+	}
+	var sorted []int
+	for v := range present {
+		sorted = append(sorted, v)
+	}
+	sort.Ints(sorted)
+
+	if b.Root.Size != len(sorted) {
+		t.Fatalf("Root.Size = %d, want %d", b.Root.Size, len(sorted))
+	}
+	for i, v := range sorted {
+		if got := b.Rank(v); got != i {
+			t.Errorf("BST.Rank(%d) = %d, want %d", v, got, i)
+		}
+		if got, found := b.Select(i); !found || got != v {
+			t.Errorf("BST.Select(%d) = %d, %v, want %d, true", i, got, found, v)
+		}
+	}
+	// Values that aren't in the tree rank by how many values are below them
+	for v := -1; v <= 500; v++ {
+		if present[v] {
+			continue
+		}
+		want := sort.SearchInts(sorted, v)
+		if got := b.Rank(v); got != want {
+			t.Errorf("BST.Rank(%d) = %d, want %d", v, got, want)
+		}
+	}
+	for _, k := range []int{-1, len(sorted)} {
+		if _, found := b.Select(k); found {
+			t.Errorf("BST.Select(%d) found a value out of range", k)
+		}
+	}
+}
@@ -0,0 +1,35 @@
+package bst
+
+import (
+	"iter"
+)
+
+// Range queries over the inclusive interval [lo, hi], walked by treewalk. Subtrees that fall
+// entirely outside the interval are never entered, so visiting k values costs O(height + k).
+
+// RangeFunc :: func :: Calls f, in sort order, for every value v with lo <= v <= hi, until f returns false
+func (b BST[T]) RangeFunc(lo, hi T, f NodeFunc[T]) {
+	walk[T]().Range(b.Root, lo, hi, b.comparator(), f)
+}
+
+// Range :: func :: Returns an iterator over every value v with lo <= v <= hi, in sort order.
+// Breaking out of the loop stops the walk.
+func (b *BST[T]) Range(lo, hi T) iter.Seq[T] {
+	compare := b.comparator()
+	return func(yield func(T) bool) {
+		walk[T]().Range(b.Root, lo, hi, compare, yield)
+	}
+}
+
+// CountRange :: func :: Returns the number of values v with lo <= v <= hi.
+// Worked out from Rank rather than by walking the range, so it's O(height) however many match.
+func (b BST[T]) CountRange(lo, hi T) int {
+	if b.comparator()(lo, hi) > 0 {
+		return 0
+	}
+	count := b.Rank(hi) - b.Rank(lo)
+	if _, found := b.Find(hi); found {
+		count++
+	}
+	return count
+}
@@ -0,0 +1,92 @@
+package bst
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestBST_Range(t *testing.T) {
+	tree := treeOf(40, 20, 60, 10, 30, 50, 70, 25, 35)
+	tests := []struct {
+		name   string
+		lo, hi int
+		want   []int
+	}{
+		{name: "whole tree", lo: 0, hi: 100, want: []int{10, 20, 25, 30, 35, 40, 50, 60, 70}},
+		{name: "bounds are inclusive", lo: 25, hi: 50, want: []int{25, 30, 35, 40, 50}},
+		{name: "bounds between values", lo: 26, hi: 49, want: []int{30, 35, 40}},
+		{name: "single value", lo: 35, hi: 35, want: []int{35}},
+		{name: "below every value", lo: 0, hi: 5},
+		{name: "above every value", lo: 75, hi: 100},
+		{name: "inverted bounds", lo: 50, hi: 25},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got []int
+			tree.RangeFunc(tt.lo, tt.hi, func(v int) bool {
+				got = append(got, v)
+				return true
+			})
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("BST.RangeFunc() = %v, want %v", got, tt.want)
+			}
+			got = nil
+			for v := range tree.Range(tt.lo, tt.hi) {
+				got = append(got, v)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("BST.Range() = %v, want %v", got, tt.want)
+			}
+			if count := tree.CountRange(tt.lo, tt.hi); count != len(tt.want) {
+				t.Errorf("BST.CountRange() = %v, want %v", count, len(tt.want))
+			}
+		})
+	}
+}
+
+func TestBST_RangeBreak(t *testing.T) {
+	tree := treeOf(40, 20, 60, 10, 30, 50, 70)
+	var got []int
+	for v := range tree.Range(15, 65) {
+		if v > 40 {
+			break
+		}
+		got = append(got, v)
+	}
+	if want := []int{20, 30, 40}; !reflect.DeepEqual(got, want) {
+		t.Errorf("BST.Range() with break = %v, want %v", got, want)
+	}
+}
+
+func TestBST_RangePrunes(t *testing.T) {
+	var compared []int
+	tree := NewFunc(func(a, b int) int {
+		compared = append(compared, a)
+		return a - b
+	})
+	for _, v := range []int{40, 20, 60, 10, 30, 50, 70} {
+		tree.Add(v)
+	}
+	compared = nil
+	for range tree.Range(45, 55) {
+	}
+	for _, v := range compared {
+		if v < 40 || v > 60 {
+			t.Errorf("BST.Range(45, 55) visited %v, outside the subtrees that can hold the range", v)
+		}
+	}
+}
+
+func TestBST_RangeSeesLaterAdds(t *testing.T) {
+	tree := New[int]()
+	seq := tree.Range(0, 10)
+	tree.Add(1)
+	tree.Add(2)
+	count := 0
+	for range seq {
+		count++
+	}
+	if count != 2 {
+		t.Errorf("BST.Range() created before Add yielded %d values, want 2", count)
+	}
+}
@@ -0,0 +1,32 @@
+package bst
+
+import (
+	"fmt"
+	"io"
+)
+
+// Debug rendering, for seeing the shape of a tree rather than just its InOrder values.
+// The drawing itself is shared with avl, in treewalk.
+
+// WriteDOT :: func :: Writes the BST to w as a Graphviz digraph, one vertex per Node
+// with its edges labelled L and R. Render it with e.g. `dot -Tsvg`.
+func (b BST[T]) WriteDOT(w io.Writer) error {
+	return walk[T]().WriteDOT(w, "BST", b.Root, func(n, _ *Node[T]) string {
+		return fmt.Sprintf("label=%q", fmt.Sprint(n.Value))
+	})
+}
+
+// String :: func :: Renders the BST as an ASCII tree: the Root on the first line, and each
+// Node's children on the lines below it, Left before Right. When a Node has only one
+// child the missing one is drawn as nil, so left and right can't be confused.
+//
+//	40
+//	├── 20
+//	│   ├── nil
+//	│   └── 30
+//	└── 60
+func (b BST[T]) String() string {
+	return walk[T]().String(b.Root, func(n *Node[T]) string {
+		return fmt.Sprint(n.Value)
+	})
+}
@@ -0,0 +1,101 @@
+package bst
+
+import (
+	"errors"
+	"strings"
+	"testing"
+)
+
+func TestBST_String(t *testing.T) {
+	tests := []struct {
+		name string
+		tree *BST[int]
+		want string
+	}{
+		{
+			name: "empty tree",
+			tree: New[int](),
+			want: "(empty)",
+		},
+		{
+			name: "single value",
+			tree: treeOf(1),
+			want: "1",
+		},
+		{
+			name: "missing children are drawn as nil",
+			tree: treeOf(40, 20, 60, 30, 50, 70, 65),
+			want: strings.Join([]string{
+				"40",
+				"├── 20",
+				"│   ├── nil",
+				"│   └── 30",
+				"└── 60",
+				"    ├── 50",
+				"    └── 70",
+				"        ├── 65",
+				"        └── nil",
+			}, "\n"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.tree.String(); got != tt.want {
+				t.Errorf("BST.String() =\n%v\nwant\n%v", got, tt.want)
+			}
+		})
+	}
+}
+
+type failingWriter struct{}
+
+func (failingWriter) Write([]byte) (int, error) {
+	return 0, errors.New("disk full")
+}
+
+func TestBST_WriteDOT(t *testing.T) {
+	tests := []struct {
+		name string
+		tree *BST[string]
+		want string
+	}{
+		{
+			name: "empty tree",
+			tree: New[string](),
+			want: "digraph BST {\n\tnode [shape=circle];\n}\n",
+		},
+		{
+			name: "labels are quoted",
+			tree: func() *BST[string] {
+				b := New[string]()
+				b.Add("m")
+				b.Add(`"a"`)
+				b.Add("z")
+				return b
+			}(),
+			want: `digraph BST {
+	node [shape=circle];
+	n0 [label="m"];
+	n1 [label="\"a\""];
+	n2 [label="z"];
+	n0 -> n1 [label="L"];
+	n0 -> n2 [label="R"];
+}
+`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var sb strings.Builder
+			if err := tt.tree.WriteDOT(&sb); err != nil {
+				t.Fatalf("BST.WriteDOT() error = %v", err)
+			}
+			if got := sb.String(); got != tt.want {
+				t.Errorf("BST.WriteDOT() =\n%v\nwant\n%v", got, tt.want)
+			}
+		})
+	}
+	if err := treeOf(1).WriteDOT(failingWriter{}); err == nil {
+		t.Errorf("BST.WriteDOT() to a failing writer returned no error")
+	}
+}
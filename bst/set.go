@@ -0,0 +1,24 @@
+package bst
+
+// The rest of the orderedset.OrderedSet API; Add, Remove, Min and Max live with the rest of the BST.
+
+// Contains :: func :: Reports whether a value equal to t is in the BST
+func (b BST[T]) Contains(t T) bool {
+	_, found := b.Find(t)
+	return found
+}
+
+// Len :: func :: Returns the number of values in the BST, the same as Size
+func (b BST[T]) Len() int {
+	return b.Size()
+}
+
+// Ascend :: func :: Calls f with each value in Sort Order until f returns false, the same as InOrder
+func (b BST[T]) Ascend(f NodeFunc[T]) {
+	b.InOrder(f)
+}
+
+// Descend :: func :: Calls f with each value in reverse Sort Order until f returns false
+func (b BST[T]) Descend(f NodeFunc[T]) {
+	walk[T]().InOrder(b.Root, f, true)
+}
@@ -0,0 +1,15 @@
+package bst
+
+import (
+	"go-datastructures/orderedset"
+	"go-datastructures/treetest"
+	"testing"
+)
+
+var _ orderedset.OrderedSet[int] = (*BST[int])(nil)
+
+func TestBST_OrderedSet(t *testing.T) {
+	treetest.RunSet(t, func() orderedset.OrderedSet[int] {
+		return New[int]()
+	})
+}
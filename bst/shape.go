@@ -0,0 +1,66 @@
+package bst
+
+// Shape introspection, for keeping an eye on how far a BST has degenerated from balanced.
+
+// Stats :: struct :: Summary of the shape of a tree
+type Stats struct {
+	Nodes  int
+	Height int
+	Leaves int
+	// Balance counts the Nodes by balance factor: the height of a Node's left subtree
+	// minus the height of its right. Counts far from 0 mean long one-sided chains.
+	Balance map[int]int
+}
+
+// Size :: func :: Returns the number of values in the BST
+func (b BST[T]) Size() int {
+	return b.Root.size()
+}
+
+// Height :: func :: Returns the number of Nodes on the longest path from the Root to a leaf
+func (b BST[T]) Height() int {
+	height := 0
+	walk[T]().Levels(b.Root, func([]*Node[T]) bool {
+		height++
+		return true
+	})
+	return height
+}
+
+// Levels :: func :: Returns the values level by level from the Root down, each level left to right
+func (b BST[T]) Levels() [][]T {
+	var levels [][]T
+	walk[T]().Levels(b.Root, func(level []*Node[T]) bool {
+		values := make([]T, len(level))
+		for i, n := range level {
+			values[i] = n.Value
+		}
+		levels = append(levels, values)
+		return true
+	})
+	return levels
+}
+
+// Stats :: func :: Walks the whole BST, returning a summary of its shape
+func (b BST[T]) Stats() Stats {
+	var nodes []*Node[T]
+	walk[T]().Levels(b.Root, func(level []*Node[T]) bool {
+		nodes = append(nodes, level...)
+		return true
+	})
+	stats := Stats{Nodes: len(nodes), Balance: map[int]int{}}
+	// Nodes aren't stored with their heights, so work them out. Walking the levels backwards
+	// reaches every child before its parent; a missing (nil) child looks up as height 0.
+	heights := make(map[*Node[T]]int, len(nodes))
+	for i := len(nodes) - 1; i >= 0; i-- {
+		n := nodes[i]
+		left, right := heights[n.Left], heights[n.Right]
+		heights[n] = 1 + max(left, right)
+		stats.Balance[left-right]++
+		if n.Left == nil && n.Right == nil {
+			stats.Leaves++
+		}
+	}
+	stats.Height = heights[b.Root]
+	return stats
+}
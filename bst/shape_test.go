@@ -0,0 +1,49 @@
+package bst
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestBST_Shape(t *testing.T) {
+	tests := []struct {
+		name       string
+		tree       *BST[int]
+		wantLevels [][]int
+		wantStats  Stats
+	}{
+		{
+			name:      "empty tree",
+			tree:      New[int](),
+			wantStats: Stats{Balance: map[int]int{}},
+		},
+		{
+			name:       "left heavy tree",
+			tree:       treeOf(40, 20, 60, 10, 30, 50, 70, 5),
+			wantLevels: [][]int{{40}, {20, 60}, {10, 30, 50, 70}, {5}},
+			wantStats:  Stats{Nodes: 8, Height: 4, Leaves: 4, Balance: map[int]int{0: 5, 1: 3}},
+		},
+		{
+			name:       "sorted input degenerates into a list",
+			tree:       treeOf(1, 2, 3, 4, 5),
+			wantLevels: [][]int{{1}, {2}, {3}, {4}, {5}},
+			wantStats:  Stats{Nodes: 5, Height: 5, Leaves: 1, Balance: map[int]int{-4: 1, -3: 1, -2: 1, -1: 1, 0: 1}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.tree.Levels(); !reflect.DeepEqual(got, tt.wantLevels) {
+				t.Errorf("BST.Levels() = %v, want %v", got, tt.wantLevels)
+			}
+			if got := tt.tree.Stats(); !reflect.DeepEqual(got, tt.wantStats) {
+				t.Errorf("BST.Stats() = %+v, want %+v", got, tt.wantStats)
+			}
+			if got := tt.tree.Size(); got != tt.wantStats.Nodes {
+				t.Errorf("BST.Size() = %v, want %v", got, tt.wantStats.Nodes)
+			}
+			if got := tt.tree.Height(); got != tt.wantStats.Height {
+				t.Errorf("BST.Height() = %v, want %v", got, tt.wantStats.Height)
+			}
+		})
+	}
+}
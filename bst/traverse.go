@@ -0,0 +1,64 @@
+package bst
+
+import (
+	"go-datastructures/treewalk"
+	"iter"
+)
+
+// Traversals are the shared, non-recursive ones from treewalk.
+// Every traversal takes a NodeFunc, which has the same shape as an iter.Seq yield function:
+// the methods can be called directly with a callback, or ranged over.
+//
+//	for v := range tree.PreOrder {
+//		...
+//	}
+
+// NodeFunc :: func :: Some function that takes in a stored value and does an operation on it.
+// Returning false stops the traversal.
+type NodeFunc[T any] = func(t T) bool
+
+// PreOrder :: func :: Processes current, left, right
+func (b BST[T]) PreOrder(f NodeFunc[T]) {
+	walk[T]().PreOrder(b.Root, f)
+}
+
+// InOrder :: func :: Processes left, current, right
+// Items in the list will be processed in Sort Order
+func (b BST[T]) InOrder(f NodeFunc[T]) {
+	walk[T]().InOrder(b.Root, f, false)
+}
+
+// PostOrder :: func :: Processes left, right, current
+// Root will be processed last -- Deletion of the entire tree could be a use case
+func (b BST[T]) PostOrder(f NodeFunc[T]) {
+	walk[T]().PostOrder(b.Root, f)
+}
+
+// LevelOrder :: func :: Processes the tree breadth-first, one level at a time from the Root down,
+// each level left to right
+func (b BST[T]) LevelOrder(f NodeFunc[T]) {
+	walk[T]().LevelOrder(b.Root, f)
+}
+
+// All :: func :: Returns an iterator over the values in Sort Order,
+// reading the tree when the loop starts rather than when All is called
+func (b *BST[T]) All() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		walk[T]().InOrder(b.Root, yield, false)
+	}
+}
+
+// Backward :: func :: Returns an iterator over the values in reverse Sort Order
+func (b *BST[T]) Backward() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		walk[T]().InOrder(b.Root, yield, true)
+	}
+}
+
+// walk :: func :: how the treewalk traversals read a Node
+func walk[T any]() treewalk.Nodes[*Node[T], T] {
+	return treewalk.Nodes[*Node[T], T]{
+		Value:    func(n *Node[T]) T { return n.Value },
+		Children: func(n *Node[T]) (*Node[T], *Node[T]) { return n.Left, n.Right },
+	}
+}
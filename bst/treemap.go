@@ -0,0 +1,38 @@
+package bst
+
+import (
+	"cmp"
+	"go-datastructures/treemap"
+)
+
+// TreeMap :: struct :: Ordered map from keys to values, kept in a BST of Entries ordered by Key
+type TreeMap[K, V any] = treemap.TreeMap[K, V]
+
+// Entry :: struct :: Key/value pair stored in a TreeMap
+type Entry[K, V any] = treemap.Entry[K, V]
+
+// NewTreeMap :: func :: Returns a pointer to a new TreeMap ordered by the natural ordering of K
+func NewTreeMap[K cmp.Ordered, V any]() *TreeMap[K, V] {
+	return NewTreeMapFunc[K, V](cmp.Compare[K])
+}
+
+// NewTreeMapFunc :: func :: Returns a pointer to a new TreeMap with keys ordered by compare
+func NewTreeMapFunc[K, V any](compare func(a, b K) int) *TreeMap[K, V] {
+	tree := NewFunc(treemap.ByKey[K, V](compare))
+	return treemap.New(treemap.Tree[Entry[K, V]]{
+		Add: tree.Add,
+		Remove: func(e Entry[K, V]) bool {
+			removed, _ := tree.Remove(e)
+			return removed
+		},
+		Find: func(e Entry[K, V]) (Entry[K, V], bool) {
+			if node, found := tree.Find(e); found {
+				return node.Value, true
+			}
+			return e, false
+		},
+		InOrder: func(f func(e Entry[K, V]) bool) {
+			tree.InOrder(f)
+		},
+	})
+}
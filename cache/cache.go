@@ -0,0 +1,29 @@
+package cache
+
+// Cache :: interface :: A fixed-capacity key/value cache, satisfied by lru.Cache, lfu.Cache and arc.Cache.
+// They differ only in which entry they evict to make room: the least recently used, the least
+// frequently used, or whichever of the two ARC's adaptive split currently favours.
+//
+// Get counts as a use of the entry; Peek returns the same value without counting.
+type Cache[K comparable, V any] interface {
+	Get(key K) (V, bool)
+	Peek(key K) (V, bool)
+	Put(key K, value V)
+	Remove(key K) bool
+	Len() int
+}
+
+// Replay :: func :: Runs a trace of key requests against c the way a read-through cache would:
+// each key is looked up with Get, and on a miss load is called for its value, which is Put.
+// Returns the number of requests that hit.
+func Replay[K comparable, V any](c Cache[K, V], trace []K, load func(key K) V) int {
+	hits := 0
+	for _, key := range trace {
+		if _, found := c.Get(key); found {
+			hits++
+			continue
+		}
+		c.Put(key, load(key))
+	}
+	return hits
+}
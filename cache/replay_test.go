@@ -0,0 +1,175 @@
+package cache
+
+import (
+	"fmt"
+	"math/rand"
+	"sync"
+	"testing"
+
+	"go-datastructures/arc"
+	"go-datastructures/lfu"
+	"go-datastructures/lru"
+)
+
+var (
+	_ Cache[int, int] = (*lru.Cache[int, int])(nil)
+	_ Cache[int, int] = (*lfu.Cache[int, int])(nil)
+	_ Cache[int, int] = (*arc.Cache[int, int])(nil)
+)
+
+// policies :: var :: constructors for each Cache, by name
+var policies = []struct {
+	name string
+	new  func(capacity int) Cache[int, int]
+}{
+	{name: "lru", new: func(capacity int) Cache[int, int] { return lru.New[int, int](capacity) }},
+	{name: "lfu", new: func(capacity int) Cache[int, int] { return lfu.New[int, int](capacity) }},
+	{name: "arc", new: func(capacity int) Cache[int, int] { return arc.New[int, int](capacity) }},
+}
+
+const (
+	traceCapacity = 1000
+	traceLength   = 200_000
+)
+
+// trace :: struct :: a named workload, the keys requested in order
+type trace struct {
+	name string
+	keys []int
+}
+
+// traces :: var :: deterministic workloads to replay, each generated from a fixed seed.
+// They're generated the first time they're needed rather than at init, so tests that
+// don't replay them don't pay for building them.
+var traces = sync.OnceValue(func() []trace {
+	return []trace{
+		{name: "zipf", keys: zipfTrace(1, traceLength)},
+		{name: "loop", keys: loopTrace(traceLength)},
+		{name: "scan", keys: scanTrace(2, traceLength)},
+		{name: "shift", keys: shiftTrace(3, traceLength)},
+	}
+})
+
+// zipfTrace :: func :: a skewed workload, where a few keys are requested far more than the rest
+func zipfTrace(seed int64, n int) []int {
+	zipf := rand.NewZipf(rand.New(rand.NewSource(seed)), 1.1, 1, 100*traceCapacity)
+	keys := make([]int, n)
+	for i := range keys {
+		keys[i] = int(zipf.Uint64())
+	}
+	return keys
+}
+
+// loopTrace :: func :: the same keys over and over, a few more of them than fit in the cache.
+// Every key is evicted just before it's needed again, so none of the policies hit at all.
+func loopTrace(n int) []int {
+	keys := make([]int, n)
+	for i := range keys {
+		keys[i] = i % (traceCapacity * 3 / 2)
+	}
+	return keys
+}
+
+// scanTrace :: func :: a skewed workload interrupted by long runs of keys that are never requested again
+func scanTrace(seed int64, n int) []int {
+	keys := zipfTrace(seed, n)
+	next := 1 << 30
+	for start := n / 10; start < n; start += n / 5 {
+		for i := start; i < min(n, start+2*traceCapacity); i++ {
+			keys[i] = next
+			next++
+		}
+	}
+	return keys
+}
+
+// shiftTrace :: func :: a skewed workload whose popular keys change halfway through
+func shiftTrace(seed int64, n int) []int {
+	keys := zipfTrace(seed, n)
+	for i := n / 2; i < n; i++ {
+		keys[i] += 1 << 30
+	}
+	return keys
+}
+
+func TestReplay(t *testing.T) {
+	for _, p := range policies {
+		t.Run(p.name, func(t *testing.T) {
+			c := p.new(2)
+			loads := 0
+			load := func(key int) int {
+				loads++
+				return key * 10
+			}
+			hits := Replay(c, []int{1, 2, 1, 1, 2, 3, 3}, load)
+			if hits != 4 || loads != 3 {
+				t.Errorf("Replay() = %d hits with %d loads, want 4 hits with 3 loads", hits, loads)
+			}
+			if got, found := c.Peek(3); got != 30 || !found {
+				t.Errorf("Peek(3) = %v, %v, want 30, true", got, found)
+			}
+		})
+	}
+}
+
+func TestReplay_Traces(t *testing.T) {
+	if testing.Short() {
+		t.Skip("replays every trace against every Cache; BenchmarkReplay reports the same hit rates")
+	}
+	rates := map[string]map[string]float64{}
+	for _, tr := range traces() {
+		rates[tr.name] = map[string]float64{}
+		for _, p := range policies {
+			hits := Replay(p.new(traceCapacity), tr.keys, func(key int) int { return key })
+			rates[tr.name][p.name] = float64(hits) / float64(len(tr.keys))
+		}
+		t.Logf("%-5s lru %.3f  lfu %.3f  arc %.3f", tr.name, rates[tr.name]["lru"], rates[tr.name]["lfu"], rates[tr.name]["arc"])
+	}
+	// Well-known differences between the policies, which the traces are built to show
+	for name, rate := range rates["loop"] {
+		if rate != 0 {
+			t.Errorf("loop: %s hit %.3f, want it to miss everything", name, rate)
+		}
+	}
+	if r := rates["scan"]; r["arc"] <= r["lru"] || r["lfu"] <= r["lru"] {
+		t.Errorf("scan: lru hit %.3f, lfu %.3f and arc %.3f, want lfu and arc to resist the scans", r["lru"], r["lfu"], r["arc"])
+	}
+	if r := rates["shift"]; r["lfu"] >= r["lru"] || r["arc"] <= r["lru"] {
+		t.Errorf("shift: lru hit %.3f, lfu %.3f and arc %.3f, want lfu to cling to the old popular keys and arc not to", r["lru"], r["lfu"], r["arc"])
+	}
+}
+
+// BenchmarkReplay :: func :: replays each trace against each Cache, reporting the hit rate
+// alongside the time taken
+func BenchmarkReplay(b *testing.B) {
+	for _, tr := range traces() {
+		for _, p := range policies {
+			b.Run(fmt.Sprintf("%s/%s", tr.name, p.name), func(b *testing.B) {
+				hits := 0
+				for i := 0; i < b.N; i++ {
+					hits = Replay(p.new(traceCapacity), tr.keys, func(key int) int { return key })
+				}
+				b.ReportMetric(100*float64(hits)/float64(len(tr.keys)), "hit%")
+				b.ReportMetric(float64(b.Elapsed().Nanoseconds())/float64(b.N*len(tr.keys)), "ns/request")
+			})
+		}
+	}
+}
+
+// BenchmarkCache :: func :: random requests for four times as many keys as each Cache holds,
+// loading the key on a miss
+func BenchmarkCache(b *testing.B) {
+	for _, p := range policies {
+		b.Run(p.name, func(b *testing.B) {
+			c := p.new(1024)
+			rng := rand.New(rand.NewSource(1))
+			b.ResetTimer()
+			for i := 0; i < b.N; i++ {
+				key := rng.Intn(4096)
+				if _, found := c.Get(key); !found {
+					c.Put(key, i)
+				}
+			}
+		})
+	}
+}
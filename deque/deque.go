@@ -3,48 +3,184 @@ package deque
 import (
 	"errors"
 	"go-datastructures/linkedlist"
-	"go-datastructures/model"
+	"go-datastructures/ringbuffer"
 )
 
 // Deque :: struct :: FILO collection
-type Deque struct {
-	List *linkedlist.DoublyLinkedList
+//
+// By default values are kept in List, one DoubleNode each. A Deque created with the
+// WithRingBuffer option keeps them in a growable RingBuffer instead, which saves an allocation
+// per value and keeps them next to each other in memory. List is left empty on such a Deque,
+// so code that reads List directly sees no values rather than a nil List; the Deque's own
+// methods work with either.
+type Deque[T any] struct {
+	// List holds the values, or is empty and unused if the Deque was created WithRingBuffer
+	List *linkedlist.DoublyLinkedList[T]
+	// ring holds the values instead of List when it's set, compared with equal
+	ring  *ringbuffer.RingBuffer[T]
+	equal linkedlist.EqualFunc[T]
 }
 
 // New :: func :: Returns pointer to a new Deque
-func New(values ...string) *Deque {
+func New[T comparable](values ...T) *Deque[T] {
 	l := linkedlist.NewDoublyLinked(values...)
-	return &Deque{
+	return &Deque[T]{
 		List: l,
 	}
 }
 
+// NewFunc :: func :: Returns pointer to a new Deque whose values are compared with equal
+func NewFunc[T any](equal linkedlist.EqualFunc[T], values ...T) *Deque[T] {
+	l := linkedlist.NewDoublyLinkedFunc(equal, values...)
+	return &Deque[T]{
+		List: l,
+	}
+}
+
+// NewWith :: func :: Returns pointer to a new, empty Deque configured by opts
+func NewWith[T comparable](opts ...Option) *Deque[T] {
+	return NewFuncWith(func(a, b T) bool { return a == b }, opts...)
+}
+
+// NewFuncWith :: func :: Returns pointer to a new, empty Deque whose values are compared with equal,
+// configured by opts. As with NewFunc, a nil equal compares values with ==.
+func NewFuncWith[T any](equal linkedlist.EqualFunc[T], opts ...Option) *Deque[T] {
+	var c config
+	for _, opt := range opts {
+		opt(&c)
+	}
+	if !c.ring {
+		return NewFunc(equal)
+	}
+	return &Deque[T]{
+		List:  linkedlist.NewDoublyLinkedFunc(equal),
+		ring:  ringbuffer.New[T](c.capacity),
+		equal: equal,
+	}
+}
+
+// ErrEmpty :: var :: Returned by the Pop and Peek methods, and Dequeue, when the Deque holds no values
+var ErrEmpty = errors.New("deque is empty")
+
 // Dequeue :: func :: returns the first value in the Queue,
-// and removes that value from the embedded LinkedList
-func (d *Deque) Dequeue() (model.Object, error) {
-	if d.List.Head != nil {
-		val := d.List.Head.Value
-		return val, d.List.Remove(val)
+// and removes that value from the Deque. The same as PopFirst.
+func (d *Deque[T]) Dequeue() (T, error) {
+	return d.PopFirst()
+}
+
+// PopFirst :: func :: Removes and returns the value in first position
+func (d *Deque[T]) PopFirst() (T, error) {
+	if d.ring != nil {
+		if val, ok := d.ring.PopFront(); ok {
+			return val, nil
+		}
+	} else if d.List.Head != nil {
+		return d.List.RemoveHead()
 	}
-	return model.Object{}, errors.New("deque is empty")
+	var zero T
+	return zero, ErrEmpty
+}
+
+// PopLast :: func :: Removes and returns the value in last position
+func (d *Deque[T]) PopLast() (T, error) {
+	if d.ring != nil {
+		if val, ok := d.ring.PopBack(); ok {
+			return val, nil
+		}
+	} else if d.List.Tail != nil {
+		return d.List.RemoveTail()
+	}
+	var zero T
+	return zero, ErrEmpty
 }
 
 // AddFirst :: func :: Adds a value to the Deque in first position
-func (d *Deque) AddFirst(obj model.Object) {
+func (d *Deque[T]) AddFirst(obj T) {
+	if d.ring != nil {
+		d.ring.PushFront(obj)
+		return
+	}
 	d.List.AddHead(obj)
 }
 
 // AddLast :: func :: Adds a value to the Deque in last position
-func (d *Deque) AddLast(obj model.Object) {
+func (d *Deque[T]) AddLast(obj T) {
+	if d.ring != nil {
+		d.ring.PushBack(obj)
+		return
+	}
 	d.List.AddTail(obj)
 }
 
 // Remove :: func :: Removes a value from the Queue
-func (d *Deque) Remove(obj model.Object) error {
+func (d *Deque[T]) Remove(obj T) error {
+	if d.ring != nil {
+		for i := 0; i < d.ring.Len(); i++ {
+			if val, _ := d.ring.At(i); d.equal.Equal(val, obj) {
+				d.ring.RemoveAt(i)
+				return nil
+			}
+		}
+		return linkedlist.ErrNotFound
+	}
 	return d.List.Remove(obj)
 }
 
-// PeekFirst :: func :: Returns the Deque's current value
-func (d *Deque) PeekFirst() model.Object {
-	return d.List.Current.Value
+// PeekFirst :: func :: Returns the value in first position, without removing it
+func (d *Deque[T]) PeekFirst() (T, error) {
+	if d.ring != nil {
+		if val, ok := d.ring.Front(); ok {
+			return val, nil
+		}
+	} else if d.List.Head != nil {
+		return d.List.Head.Value, nil
+	}
+	var zero T
+	return zero, ErrEmpty
+}
+
+// PeekLast :: func :: Returns the value in last position, without removing it
+func (d *Deque[T]) PeekLast() (T, error) {
+	if d.ring != nil {
+		if val, ok := d.ring.Back(); ok {
+			return val, nil
+		}
+	} else if d.List.Tail != nil {
+		return d.List.Tail.Value, nil
+	}
+	var zero T
+	return zero, ErrEmpty
+}
+
+// Len :: func :: Returns the number of values in the Deque
+func (d *Deque[T]) Len() int {
+	if d.ring != nil {
+		return d.ring.Len()
+	}
+	return d.List.Len()
+}
+
+// IsEmpty :: func :: Reports whether the Deque holds no values
+func (d *Deque[T]) IsEmpty() bool {
+	if d.ring != nil {
+		return d.ring.Len() == 0
+	}
+	return d.List.IsEmpty()
+}
+
+// Option :: func :: Configures a Deque created with NewWith or NewFuncWith
+type Option func(*config)
+
+type config struct {
+	ring     bool
+	capacity int
+}
+
+// WithRingBuffer :: func :: Keeps the Deque's values in a RingBuffer rather than a DoublyLinkedList,
+// with room for capacity of them before it first grows
+func WithRingBuffer(capacity int) Option {
+	return func(c *config) {
+		c.ring = true
+		c.capacity = capacity
+	}
 }
@@ -0,0 +1,217 @@
+package deque
+
+import (
+	"go-datastructures/linkedlist"
+	"testing"
+)
+
+// backends :: var :: a constructor for an empty Deque of each kind
+var backends = map[string]func() *Deque[string]{
+	"list": func() *Deque[string] { return NewWith[string]() },
+	"ring": func() *Deque[string] { return NewWith[string](WithRingBuffer(2)) },
+}
+
+func TestDeque_Backends(t *testing.T) {
+	for name, newDeque := range backends {
+		t.Run(name, func(t *testing.T) {
+			d := newDeque()
+			if d.List == nil {
+				t.Fatalf("List is nil on a %s Deque", name)
+			}
+			if _, err := d.Dequeue(); err == nil {
+				t.Error("Deque.Dequeue() expected error on empty deque")
+			}
+			for _, v := range []string{"c", "d", "e"} {
+				d.AddLast(v)
+			}
+			for _, v := range []string{"b", "a"} {
+				d.AddFirst(v)
+			}
+			if err := d.Remove("d"); err != nil {
+				t.Errorf("Deque.Remove(d) error = %v", err)
+			}
+			if err := d.Remove("z"); err != linkedlist.ErrNotFound {
+				t.Errorf("Deque.Remove(z) error = %v, want %v", err, linkedlist.ErrNotFound)
+			}
+			if got, err := d.PeekFirst(); got != "a" || err != nil {
+				t.Errorf("Deque.PeekFirst() = %v, %v, want a, nil", got, err)
+			}
+			if d.Len() != 4 {
+				t.Errorf("Deque.Len() = %d, want 4", d.Len())
+			}
+			for _, want := range []string{"a", "b", "c", "e"} {
+				if got, err := d.Dequeue(); got != want || err != nil {
+					t.Errorf("Deque.Dequeue() = %v, %v, want %v, nil", got, err, want)
+				}
+			}
+			if !d.IsEmpty() {
+				t.Errorf("Deque.IsEmpty() = false after dequeuing everything")
+			}
+		})
+	}
+}
+
+func TestDeque_Ends(t *testing.T) {
+	tests := []struct {
+		name      string
+		ops       func(d *Deque[string])
+		first     string
+		last      string
+		remaining int
+	}{
+		{
+			name: "one value is both ends",
+			ops: func(d *Deque[string]) {
+				d.AddLast("a")
+			},
+			first:     "a",
+			last:      "a",
+			remaining: 1,
+		},
+		{
+			name: "added at both ends",
+			ops: func(d *Deque[string]) {
+				d.AddLast("b")
+				d.AddFirst("a")
+				d.AddLast("c")
+			},
+			first:     "a",
+			last:      "c",
+			remaining: 3,
+		},
+		{
+			name: "popped from both ends",
+			ops: func(d *Deque[string]) {
+				for _, v := range []string{"a", "b", "c", "d"} {
+					d.AddLast(v)
+				}
+				d.PopFirst()
+				d.PopLast()
+			},
+			first:     "b",
+			last:      "c",
+			remaining: 2,
+		},
+		{
+			name: "duplicates pop from the right end",
+			ops: func(d *Deque[string]) {
+				for _, v := range []string{"a", "b", "a"} {
+					d.AddLast(v)
+				}
+				d.PopLast()
+			},
+			first:     "a",
+			last:      "b",
+			remaining: 2,
+		},
+	}
+	for name, newDeque := range backends {
+		for _, tt := range tests {
+			t.Run(name+"/"+tt.name, func(t *testing.T) {
+				d := newDeque()
+				tt.ops(d)
+				if got, err := d.PeekFirst(); got != tt.first || err != nil {
+					t.Errorf("Deque.PeekFirst() = %v, %v, want %v, nil", got, err, tt.first)
+				}
+				if got, err := d.PeekLast(); got != tt.last || err != nil {
+					t.Errorf("Deque.PeekLast() = %v, %v, want %v, nil", got, err, tt.last)
+				}
+				if d.Len() != tt.remaining {
+					t.Errorf("Deque.Len() = %d, want %d", d.Len(), tt.remaining)
+				}
+				if got, err := d.PopLast(); got != tt.last || err != nil {
+					t.Errorf("Deque.PopLast() = %v, %v, want %v, nil", got, err, tt.last)
+				}
+				if d.Len() != tt.remaining-1 {
+					t.Errorf("Deque.Len() = %d after PopLast(), want %d", d.Len(), tt.remaining-1)
+				}
+			})
+		}
+	}
+}
+
+func TestDeque_Empty(t *testing.T) {
+	for name, newDeque := range backends {
+		d := newDeque()
+		d.AddFirst("a")
+		d.PopLast()
+		for method, get := range map[string]func() (string, error){
+			"Dequeue":   d.Dequeue,
+			"PopFirst":  d.PopFirst,
+			"PopLast":   d.PopLast,
+			"PeekFirst": d.PeekFirst,
+			"PeekLast":  d.PeekLast,
+		} {
+			if got, err := get(); got != "" || err != ErrEmpty {
+				t.Errorf("%s: Deque.%s() = %q, %v, want \"\", %v", name, method, got, err, ErrEmpty)
+			}
+		}
+	}
+}
+
+func TestDeque_Duplicates(t *testing.T) {
+	// Values that compare equal are still popped from the end asked for
+	type job struct{ ID, Attempt int }
+	byID := func(a, b job) bool { return a.ID == b.ID }
+	for _, d := range []*Deque[job]{NewFunc(byID), NewFuncWith(byID, WithRingBuffer(0))} {
+		for attempt := range 3 {
+			d.AddLast(job{ID: 1, Attempt: attempt})
+		}
+		if got, _ := d.PopLast(); got.Attempt != 2 {
+			t.Errorf("Deque.PopLast() = %v, want attempt 2", got)
+		}
+		if got, _ := d.PopFirst(); got.Attempt != 0 {
+			t.Errorf("Deque.PopFirst() = %v, want attempt 0", got)
+		}
+	}
+}
+
+// BenchmarkDeque :: func :: keeps a Deque at a steady length, adding a value at either end for each dequeued
+func BenchmarkDeque(b *testing.B) {
+	for _, name := range []string{"list", "ring"} {
+		b.Run(name, func(b *testing.B) {
+			d := NewWith[int]()
+			if name == "ring" {
+				d = NewWith[int](WithRingBuffer(0))
+			}
+			for i := 0; i < 1024; i++ {
+				d.AddLast(i)
+			}
+			b.ReportAllocs()
+			b.ResetTimer()
+			for i := 0; i < b.N; i++ {
+				if i%2 == 0 {
+					d.AddFirst(i)
+				} else {
+					d.AddLast(i)
+				}
+				d.Dequeue()
+			}
+		})
+	}
+}
+
+func TestDeque_NilEqual(t *testing.T) {
+	// A nil equal falls back to == whichever constructor and backend it's given to
+	constructors := map[string]func() *Deque[string]{
+		"NewFunc":                     func() *Deque[string] { return NewFunc[string](nil) },
+		"NewFuncWith":                 func() *Deque[string] { return NewFuncWith[string](nil) },
+		"NewFuncWith(WithRingBuffer)": func() *Deque[string] { return NewFuncWith[string](nil, WithRingBuffer(2)) },
+	}
+	for name, newDeque := range constructors {
+		t.Run(name, func(t *testing.T) {
+			d := newDeque()
+			d.AddLast("a")
+			d.AddLast("b")
+			if err := d.Remove("c"); err == nil {
+				t.Errorf("Deque.Remove(c) expected error, c was never added")
+			}
+			if err := d.Remove("a"); err != nil {
+				t.Errorf("Deque.Remove(a) error = %v", err)
+			}
+			if got := d.Len(); got != 1 {
+				t.Errorf("Deque.Len() = %d after Remove(a), want 1", got)
+			}
+		})
+	}
+}
@@ -1,3 +1,3 @@
 module go-datastructures
 
-go 1.18
+go 1.24
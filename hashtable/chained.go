@@ -0,0 +1,104 @@
+package hashtable
+
+import (
+	"go-datastructures/linkedlist"
+)
+
+// chained :: struct :: Separate chaining table. Each bucket is a SinglyLinkedList of the
+// entries whose hashes land on it, created the first time something lands there.
+// Unlike open addressing the number of entries can exceed the number of buckets.
+type chained[K comparable, V any] struct {
+	buckets []*linkedlist.SinglyLinkedList[entry[K, V]]
+	mask    uint64
+	count   int
+}
+
+type entry[K comparable, V any] struct {
+	hash  uint64
+	key   K
+	value V
+}
+
+// sameKey :: func :: EqualFunc for bucket lists, entries only match on their key
+func sameKey[K comparable, V any](a, b entry[K, V]) bool {
+	return a.hash == b.hash && a.key == b.key
+}
+
+// newChained :: func :: size must be a power of two
+func newChained[K comparable, V any](size int) *chained[K, V] {
+	return &chained[K, V]{
+		buckets: make([]*linkedlist.SinglyLinkedList[entry[K, V]], size),
+		mask:    uint64(size - 1),
+	}
+}
+
+func (t *chained[K, V]) len() int {
+	return t.count
+}
+
+func (t *chained[K, V]) cap() int {
+	return len(t.buckets)
+}
+
+func (t *chained[K, V]) get(hash uint64, key K) (V, bool) {
+	if bucket := t.buckets[hash&t.mask]; bucket != nil {
+		if node, found := bucket.FindNode(entry[K, V]{hash: hash, key: key}); found {
+			return node.Value.value, true
+		}
+	}
+	var zero V
+	return zero, false
+}
+
+func (t *chained[K, V]) put(hash uint64, key K, value V) bool {
+	e := entry[K, V]{hash: hash, key: key, value: value}
+	bucket := t.buckets[hash&t.mask]
+	if bucket == nil {
+		bucket = linkedlist.NewSinglyLinkedFunc(sameKey[K, V])
+		t.buckets[hash&t.mask] = bucket
+	} else if node, found := bucket.FindNode(e); found {
+		node.Value.value = value
+		return false
+	}
+	bucket.Add(e)
+	t.count++
+	return true
+}
+
+func (t *chained[K, V]) delete(hash uint64, key K) bool {
+	bucket := t.buckets[hash&t.mask]
+	if bucket == nil || bucket.Remove(entry[K, V]{hash: hash, key: key}) != nil {
+		return false
+	}
+	t.count--
+	return true
+}
+
+// pop :: func :: removes the entry at the Head of bucket i
+func (t *chained[K, V]) pop(i int) (uint64, K, V, bool) {
+	bucket := t.buckets[i]
+	if bucket == nil || bucket.Head == nil {
+		var e entry[K, V]
+		return 0, e.key, e.value, false
+	}
+	e := bucket.Head.Value
+	// Keys are unique within a bucket, so this removes the Head
+	_ = bucket.Remove(e)
+	t.count--
+	return e.hash, e.key, e.value, true
+}
+
+// each :: func :: visits buckets in order, and each bucket from its Head, until f returns false
+func (t *chained[K, V]) each(f func(hash uint64, key K, value V) bool) bool {
+	for _, bucket := range t.buckets {
+		if bucket == nil {
+			continue
+		}
+		for n := bucket.Head; n != nil; n = n.Next {
+			if !f(n.Value.hash, n.Value.key, n.Value.value) {
+				return false
+			}
+		}
+	}
+	return true
+}
@@ -0,0 +1,139 @@
+package hashtable
+
+import (
+	"hash/maphash"
+	"math/bits"
+)
+
+// Hasher :: interface :: Turns keys into the 64-bit hashes a HashTable uses to place them.
+// Keys that are equal must hash the same; anything else is a trade-off between speed
+// and how evenly keys spread across the table.
+type Hasher[K any] interface {
+	Hash(key K) uint64
+}
+
+// HasherFunc :: func :: Adapts a plain function to a Hasher
+type HasherFunc[K any] func(key K) uint64
+
+// Hash :: func :: Calls f(key)
+func (f HasherFunc[K]) Hash(key K) uint64 {
+	return f(key)
+}
+
+// MapHash :: struct :: Hashes any comparable key with hash/maphash, which is what a
+// HashTable uses when no Hasher is supplied. Hashes are randomized per seed, and each
+// HashTable gets a fresh one, so two tables can iterate the same entries in different orders.
+type MapHash[K comparable] struct {
+	seed maphash.Seed
+}
+
+// NewMapHash :: func :: Returns a MapHash with a fresh random seed
+func NewMapHash[K comparable]() MapHash[K] {
+	return MapHash[K]{seed: maphash.MakeSeed()}
+}
+
+// Hash :: func :: Hashes key with the MapHash's seed
+func (m MapHash[K]) Hash(key K) uint64 {
+	return maphash.Comparable(m.seed, key)
+}
+
+const (
+	fnvOffset64 = 14695981039346656037
+	fnvPrime64  = 1099511628211
+)
+
+// FNV1a :: struct :: 64-bit FNV-1a over string keys. Simple and deterministic across runs,
+// but easy to attack since there's no seed.
+type FNV1a[K ~string] struct{}
+
+// Hash :: func :: Hashes key a byte at a time
+func (FNV1a[K]) Hash(key K) uint64 {
+	h := uint64(fnvOffset64)
+	for i := 0; i < len(key); i++ {
+		h ^= uint64(key[i])
+		h *= fnvPrime64
+	}
+	return h
+}
+
+const (
+	xxPrime1 uint64 = 11400714785074694791
+	xxPrime2 uint64 = 14029467366897019727
+	xxPrime3 uint64 = 1609587929392839161
+	xxPrime4 uint64 = 9650029242287828579
+	xxPrime5 uint64 = 2870177450012600261
+)
+
+// XXHash :: struct :: XXH64 over string keys. Reads eight bytes at a time so it pulls ahead
+// of FNV1a on longer keys, and Seed can be varied to make collisions harder to predict.
+type XXHash[K ~string] struct {
+	Seed uint64
+}
+
+// Hash :: func :: Hashes key with the XXH64 algorithm
+func (x XXHash[K]) Hash(key K) uint64 {
+	n := len(key)
+	i := 0
+	var h uint64
+	if n >= 32 {
+		v1 := x.Seed + xxPrime1 + xxPrime2
+		v2 := x.Seed + xxPrime2
+		v3 := x.Seed
+		v4 := x.Seed - xxPrime1
+		for ; i+32 <= n; i += 32 {
+			v1 = xxRound(v1, readUint64(key[i:]))
+			v2 = xxRound(v2, readUint64(key[i+8:]))
+			v3 = xxRound(v3, readUint64(key[i+16:]))
+			v4 = xxRound(v4, readUint64(key[i+24:]))
+		}
+		h = bits.RotateLeft64(v1, 1) + bits.RotateLeft64(v2, 7) + bits.RotateLeft64(v3, 12) + bits.RotateLeft64(v4, 18)
+		h = xxMergeRound(h, v1)
+		h = xxMergeRound(h, v2)
+		h = xxMergeRound(h, v3)
+		h = xxMergeRound(h, v4)
+	} else {
+		h = x.Seed + xxPrime5
+	}
+	h += uint64(n)
+	for ; i+8 <= n; i += 8 {
+		h ^= xxRound(0, readUint64(key[i:]))
+		h = bits.RotateLeft64(h, 27)*xxPrime1 + xxPrime4
+	}
+	if i+4 <= n {
+		h ^= uint64(readUint32(key[i:])) * xxPrime1
+		h = bits.RotateLeft64(h, 23)*xxPrime2 + xxPrime3
+		i += 4
+	}
+	for ; i < n; i++ {
+		h ^= uint64(key[i]) * xxPrime5
+		h = bits.RotateLeft64(h, 11) * xxPrime1
+	}
+	h ^= h >> 33
+	h *= xxPrime2
+	h ^= h >> 29
+	h *= xxPrime3
+	h ^= h >> 32
+	return h
+}
+
+func xxRound(acc, input uint64) uint64 {
+	acc += input * xxPrime2
+	acc = bits.RotateLeft64(acc, 31)
+	return acc * xxPrime1
+}
+
+func xxMergeRound(acc, val uint64) uint64 {
+	acc ^= xxRound(0, val)
+	return acc*xxPrime1 + xxPrime4
+}
+
+// readUint64 :: func :: little-endian read of the first eight bytes of s
+func readUint64[K ~string](s K) uint64 {
+	return uint64(s[0]) | uint64(s[1])<<8 | uint64(s[2])<<16 | uint64(s[3])<<24 |
+		uint64(s[4])<<32 | uint64(s[5])<<40 | uint64(s[6])<<48 | uint64(s[7])<<56
+}
+
+// readUint32 :: func :: little-endian read of the first four bytes of s
+func readUint32[K ~string](s K) uint32 {
+	return uint32(s[0]) | uint32(s[1])<<8 | uint32(s[2])<<16 | uint32(s[3])<<24
+}
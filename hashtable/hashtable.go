@@ -1,10 +1,259 @@
 package hashtable
 
-import "go-datastructures/model"
+const (
+	defaultCapacity          = 8
+	defaultLoadFactor        = 0.85
+	defaultChainedLoadFactor = 1.0
+)
 
-type implMap map[string]model.Object
+// HashTable :: struct :: Generic hash map built from scratch rather than on top of Go's map.
+// By default entries live in a single slice using open addressing with Robin Hood probing;
+// WithChaining switches to separate chaining with linked list buckets instead. Either way
+// the table doubles whenever the number of entries would pass the configured load factor.
+// The zero value is an empty, open addressing HashTable ready to use.
+//
+// By default that doubling happens all at once, rehashing every entry inside a single Put.
+// WithIncrementalResize spreads the work out instead: the old table stays live next to the
+// new one, and every Put, Get and Delete moves a few of its slots or buckets across until
+// it is empty.
+type HashTable[K comparable, V any] struct {
+	table      table[K, V]
+	hasher     Hasher[K]
+	loadFactor float64
+	chaining   bool
 
-// Go's maps are HashTables, but to embed the map[string]model.Object it needed a custom type.
-type HashTable struct {
-	implMap
+	// Incremental resizing: old is the table being drained, cursor the next slot or bucket
+	// to move out of it, and step how many to move per operation (0 means resize all at once)
+	old    table[K, V]
+	cursor int
+	step   int
+}
+
+// New :: func :: Returns a pointer to a new HashTable configured by opts
+func New[K comparable, V any](opts ...Option[K]) *HashTable[K, V] {
+	c := config[K]{
+		capacity: defaultCapacity,
+	}
+	for _, opt := range opts {
+		opt(&c)
+	}
+	h := &HashTable[K, V]{
+		loadFactor: c.loadFactor,
+		chaining:   c.chaining,
+		step:       c.step,
+	}
+	if h.loadFactor >= 1 && !h.chaining {
+		panic("hashtable: open addressing needs a load factor less than 1")
+	}
+	h.hasher = c.hasher
+	h.init(c.capacity)
+	return h
+}
+
+// Put :: func :: Stores value under key, replacing any value already stored there
+func (h *HashTable[K, V]) Put(key K, value V) {
+	if h.table == nil {
+		h.init(defaultCapacity)
+	}
+	h.migrate()
+	if float64(h.Len()+1) > h.loadFactor*float64(h.table.cap()) {
+		h.resize(h.table.cap() * 2)
+	}
+	hash := h.hasher.Hash(key)
+	if h.old != nil {
+		// The new value lives in the new table, and a stale copy left behind would be
+		// carried across later and clobber it
+		h.old.delete(hash, key)
+	}
+	h.table.put(hash, key, value)
+}
+
+// Get :: func :: Returns the value stored under key, and whether there was one
+func (h *HashTable[K, V]) Get(key K) (V, bool) {
+	if h.table == nil {
+		var zero V
+		return zero, false
+	}
+	h.migrate()
+	hash := h.hasher.Hash(key)
+	if value, found := h.table.get(hash, key); found || h.old == nil {
+		return value, found
+	}
+	return h.old.get(hash, key)
+}
+
+// Delete :: func :: Removes key from the HashTable, returning false if it wasn't present
+func (h *HashTable[K, V]) Delete(key K) bool {
+	if h.table == nil {
+		return false
+	}
+	h.migrate()
+	hash := h.hasher.Hash(key)
+	if h.table.delete(hash, key) {
+		return true
+	}
+	return h.old != nil && h.old.delete(hash, key)
+}
+
+// Len :: func :: Returns the number of entries in the HashTable
+func (h *HashTable[K, V]) Len() int {
+	if h.table == nil {
+		return 0
+	}
+	if h.old != nil {
+		return h.table.len() + h.old.len()
+	}
+	return h.table.len()
+}
+
+// Range :: func :: Calls f for each entry until f returns false.
+// Unlike ranging over a map, entries are visited in slot (or bucket) order, so the
+// order only changes when the HashTable does. Mid-migration, entries still waiting
+// in the old table are visited first.
+//
+// That order is only stable for this HashTable. The default hash is seeded randomly for
+// each HashTable, so another one given the same Puts, or the same program run again,
+// visits the entries in a different order. For an order that's repeatable across tables
+// and runs, create them WithHasher(FNV1a or XXHash), which aren't seeded randomly.
+func (h *HashTable[K, V]) Range(f func(key K, value V) bool) {
+	if h.table == nil {
+		return
+	}
+	each := func(_ uint64, key K, value V) bool {
+		return f(key, value)
+	}
+	if h.old != nil && !h.old.each(each) {
+		return
+	}
+	h.table.each(each)
+}
+
+// init :: func :: fills in defaults and allocates enough room to hold capacity entries without resizing
+func (h *HashTable[K, V]) init(capacity int) {
+	if h.loadFactor == 0 {
+		h.loadFactor = defaultLoadFactor
+		if h.chaining {
+			h.loadFactor = defaultChainedLoadFactor
+		}
+	}
+	if h.hasher == nil {
+		h.hasher = NewMapHash[K]()
+	}
+	size := 1
+	for float64(capacity) > h.loadFactor*float64(size) {
+		size <<= 1
+	}
+	h.table = h.newTable(size)
+}
+
+// newTable :: func :: size must be a power of two
+func (h *HashTable[K, V]) newTable(size int) table[K, V] {
+	if h.chaining {
+		return newChained[K, V](size)
+	}
+	return newRobinHood[K, V](size)
+}
+
+// resize :: func :: replaces the table with a new one of the given size. Unless resizing
+// incrementally, every entry is moved across before returning.
+func (h *HashTable[K, V]) resize(size int) {
+	if h.old != nil {
+		// Still draining the last resize, which only happens when step is too small to
+		// keep up with inserts, so finish it off now
+		h.drain(h.old.cap())
+	}
+	h.old, h.cursor = h.table, 0
+	h.table = h.newTable(size)
+	if h.step == 0 {
+		h.drain(h.old.cap())
+	}
+}
+
+// migrate :: func :: moves the next step slots or buckets out of the old table, if there is one
+func (h *HashTable[K, V]) migrate() {
+	if h.old != nil {
+		h.drain(h.step)
+	}
+}
+
+// drain :: func :: empties up to n slots or buckets of the old table into the new one,
+// dropping the old table once it's empty
+func (h *HashTable[K, V]) drain(n int) {
+	for ; n > 0 && h.cursor < h.old.cap(); n-- {
+		for {
+			hash, key, value, ok := h.old.pop(h.cursor)
+			if !ok {
+				break
+			}
+			h.table.put(hash, key, value)
+		}
+		h.cursor++
+	}
+	if h.cursor == h.old.cap() {
+		h.old, h.cursor = nil, 0
+	}
+}
+
+// Option :: func :: Configures a HashTable created with New. Options are typed by the
+// HashTable's key type, so a Hasher for the wrong keys doesn't compile:
+//
+//	hashtable.New[string, int](hashtable.WithCapacity[string](64), hashtable.WithHasher[string](hashtable.FNV1a[string]{}))
+type Option[K comparable] func(*config[K])
+
+type config[K comparable] struct {
+	capacity   int
+	loadFactor float64
+	chaining   bool
+	hasher     Hasher[K]
+	step       int
+}
+
+// WithCapacity :: func :: Sizes the HashTable to hold n entries before its first resize
+func WithCapacity[K comparable](n int) Option[K] {
+	return func(c *config[K]) {
+		if n < 0 {
+			panic("hashtable: capacity must not be negative")
+		}
+		c.capacity = n
+	}
+}
+
+// WithLoadFactor :: func :: Sets the ratio of entries to slots (or buckets) the HashTable
+// may reach before it doubles in size. Open addressing needs a load factor between 0 and 1,
+// chaining accepts anything above 0. Defaults to 0.85 for open addressing and 1 for chaining.
+func WithLoadFactor[K comparable](f float64) Option[K] {
+	return func(c *config[K]) {
+		if f <= 0 {
+			panic("hashtable: load factor must be greater than 0")
+		}
+		c.loadFactor = f
+	}
+}
+
+// WithChaining :: func :: Stores entries in linked list buckets instead of probing a single slice
+func WithChaining[K comparable]() Option[K] {
+	return func(c *config[K]) {
+		c.chaining = true
+	}
+}
+
+// WithHasher :: func :: Hashes keys with h instead of the default MapHash.
+// An unseeded h, like FNV1a, makes Range visit the same Puts in the same order every time.
+func WithHasher[K comparable](h Hasher[K]) Option[K] {
+	return func(c *config[K]) {
+		c.hasher = h
+	}
+}
+
+// WithIncrementalResize :: func :: Spreads each resize across later operations instead of
+// rehashing everything at once, moving step slots or buckets of the old table per Put, Get
+// or Delete. A step of at least 1/load factor (2 at the defaults) guarantees a migration
+// finishes before the next one is due; smaller steps fall back to finishing it in one go.
+func WithIncrementalResize[K comparable](step int) Option[K] {
+	return func(c *config[K]) {
+		if step < 1 {
+			panic("hashtable: incremental resize step must be at least 1")
+		}
+		c.step = step
+	}
 }
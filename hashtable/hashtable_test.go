@@ -0,0 +1,606 @@
+package hashtable
+
+import (
+	"math/rand"
+	"reflect"
+	"sort"
+	"strconv"
+	"testing"
+	"time"
+)
+
+// backends :: every storage mode the behavioural tests are run against
+var backends = []struct {
+	name     string
+	chaining bool
+}{
+	{
+		name: "open addressing",
+	},
+	{
+		name:     "chaining",
+		chaining: true,
+	},
+}
+
+// backendOpts :: func :: the Options that select a backend, for a HashTable keyed by K
+func backendOpts[K comparable](chaining bool) []Option[K] {
+	if chaining {
+		return []Option[K]{WithChaining[K]()}
+	}
+	return nil
+}
+
+func TestHashTable_Put(t *testing.T) {
+	tests := []struct {
+		name    string
+		keys    []string
+		wantLen int
+	}{
+		{
+			name:    "single key is stored",
+			keys:    []string{"first"},
+			wantLen: 1,
+		},
+		{
+			name:    "repeated keys are replaced rather than duplicated",
+			keys:    []string{"first", "second", "first", "second"},
+			wantLen: 2,
+		},
+		{
+			name:    "table grows past its initial capacity",
+			keys:    numbered(100),
+			wantLen: 100,
+		},
+	}
+	for _, backend := range backends {
+		for _, tt := range tests {
+			t.Run(backend.name+"/"+tt.name, func(t *testing.T) {
+				h := tableOf(backendOpts[string](backend.chaining), tt.keys...)
+				if got := h.Len(); got != tt.wantLen {
+					t.Errorf("HashTable.Len() = %d, want %d", got, tt.wantLen)
+				}
+				// The last value Put for a key wins
+				want := map[string]int{}
+				for i, k := range tt.keys {
+					want[k] = i
+				}
+				for k, v := range want {
+					if got, found := h.Get(k); !found || got != v {
+						t.Errorf("HashTable.Get(%q) = %d, %v, want %d, true", k, got, found, v)
+					}
+				}
+				checkTable(t, h)
+			})
+		}
+	}
+}
+
+func TestHashTable_Get(t *testing.T) {
+	tests := []struct {
+		name      string
+		keys      []string
+		key       string
+		want      int
+		wantFound bool
+	}{
+		{
+			name: "missing key",
+			keys: []string{"first", "second"},
+			key:  "third",
+		},
+		{
+			name:      "present key",
+			keys:      []string{"first", "second"},
+			key:       "second",
+			want:      1,
+			wantFound: true,
+		},
+	}
+	for _, backend := range backends {
+		for _, tt := range tests {
+			t.Run(backend.name+"/"+tt.name, func(t *testing.T) {
+				got, found := tableOf(backendOpts[string](backend.chaining), tt.keys...).Get(tt.key)
+				if got != tt.want || found != tt.wantFound {
+					t.Errorf("HashTable.Get() = %d, %v, want %d, %v", got, found, tt.want, tt.wantFound)
+				}
+			})
+		}
+	}
+}
+
+func TestHashTable_ZeroValue(t *testing.T) {
+	h := &HashTable[string, int]{}
+	if _, found := h.Get("first"); found {
+		t.Error("HashTable.Get() found a key in a zero value HashTable")
+	}
+	if h.Delete("first") {
+		t.Error("HashTable.Delete() removed a key from a zero value HashTable")
+	}
+	if h.Len() != 0 {
+		t.Errorf("HashTable.Len() = %d, want 0", h.Len())
+	}
+	h.Put("first", 1)
+	if got, found := h.Get("first"); !found || got != 1 {
+		t.Errorf("HashTable.Get() = %d, %v after Put() on a zero value HashTable", got, found)
+	}
+}
+
+func TestHashTable_Delete(t *testing.T) {
+	tests := []struct {
+		name    string
+		keys    []string
+		key     string
+		want    bool
+		wantLen int
+	}{
+		{
+			name:    "missing key",
+			keys:    []string{"first", "second"},
+			key:     "third",
+			wantLen: 2,
+		},
+		{
+			name:    "present key",
+			keys:    []string{"first", "second"},
+			key:     "first",
+			want:    true,
+			wantLen: 1,
+		},
+		{
+			name:    "key inside a long probe cluster",
+			keys:    numbered(50),
+			key:     "25",
+			want:    true,
+			wantLen: 49,
+		},
+	}
+	for _, backend := range backends {
+		for _, tt := range tests {
+			t.Run(backend.name+"/"+tt.name, func(t *testing.T) {
+				h := tableOf(backendOpts[string](backend.chaining), tt.keys...)
+				if got := h.Delete(tt.key); got != tt.want {
+					t.Errorf("HashTable.Delete() = %v, want %v", got, tt.want)
+				}
+				if _, found := h.Get(tt.key); found {
+					t.Errorf("HashTable.Delete() key still found after Delete()")
+				}
+				if got := h.Len(); got != tt.wantLen {
+					t.Errorf("HashTable.Len() = %d, want %d", got, tt.wantLen)
+				}
+				checkTable(t, h)
+			})
+		}
+	}
+}
+
+func TestHashTable_Range(t *testing.T) {
+	for _, backend := range backends {
+		t.Run(backend.name, func(t *testing.T) {
+			h := tableOf(backendOpts[string](backend.chaining), numbered(20)...)
+			var first, second []string
+			h.Range(func(k string, _ int) bool {
+				first = append(first, k)
+				return true
+			})
+			h.Range(func(k string, _ int) bool {
+				second = append(second, k)
+				return true
+			})
+			if !reflect.DeepEqual(first, second) {
+				t.Errorf("HashTable.Range() order changed between calls: %v then %v", first, second)
+			}
+			sort.Strings(first)
+			want := numbered(20)
+			sort.Strings(want)
+			if !reflect.DeepEqual(first, want) {
+				t.Errorf("HashTable.Range() = %v, want %v", first, want)
+			}
+			calls := 0
+			h.Range(func(string, int) bool {
+				calls++
+				return calls < 5
+			})
+			if calls != 5 {
+				t.Errorf("HashTable.Range() kept going after f returned false, calls = %d", calls)
+			}
+		})
+	}
+}
+
+func TestHashTable_Options(t *testing.T) {
+	h := New[int, int](WithCapacity[int](100), WithLoadFactor[int](0.5))
+	slots := h.table.cap()
+	if slots < 200 {
+		t.Errorf("WithCapacity(100) at load factor 0.5 allocated %d slots, want >= 200", slots)
+	}
+	// Slots are rounded up to a power of two, so fill to exactly half of what was allocated
+	for i := 0; i < slots/2; i++ {
+		h.Put(i, i)
+	}
+	if h.table.cap() != slots {
+		t.Errorf("HashTable resized before reaching its load factor")
+	}
+	h.Put(slots, slots)
+	if h.table.cap() != slots*2 {
+		t.Errorf("HashTable did not double once the load factor was passed, slots = %d", h.table.cap())
+	}
+
+	chained := New[int, int](WithChaining[int](), WithCapacity[int](64), WithLoadFactor[int](4))
+	if got := chained.table.cap(); got != 16 {
+		t.Errorf("WithCapacity(64) at load factor 4 allocated %d buckets, want 16", got)
+	}
+
+	panics := []struct {
+		name string
+		opts []Option[int]
+	}{
+		{name: "zero load factor", opts: []Option[int]{WithLoadFactor[int](0)}},
+		{name: "negative load factor", opts: []Option[int]{WithLoadFactor[int](-0.5)}},
+		{name: "open addressing with a full load factor", opts: []Option[int]{WithLoadFactor[int](1)}},
+		{name: "open addressing with an overfull load factor", opts: []Option[int]{WithLoadFactor[int](1.5)}},
+		{name: "negative capacity", opts: []Option[int]{WithCapacity[int](-1)}},
+		{name: "incremental resize without a step", opts: []Option[int]{WithIncrementalResize[int](0)}},
+	}
+	for _, tt := range panics {
+		t.Run(tt.name, func(t *testing.T) {
+			defer func() {
+				if recover() == nil {
+					t.Errorf("New() did not panic")
+				}
+			}()
+			New[int, int](tt.opts...)
+		})
+	}
+}
+
+// TestHashTable_Random :: func :: Runs a random mix of operations against the built-in map,
+// for every backend and a range of hashers including ones that collide on purpose
+func TestHashTable_Random(t *testing.T) {
+	hashers := []struct {
+		name   string
+		hasher Hasher[string]
+	}{
+		{name: "maphash", hasher: NewMapHash[string]()},
+		{name: "fnv1a", hasher: FNV1a[string]{}},
+		{name: "xxhash", hasher: XXHash[string]{Seed: 7}},
+		{name: "sixteen distinct hashes", hasher: HasherFunc[string](func(k string) uint64 {
+			return FNV1a[string]{}.Hash(k) & 0xf
+		})},
+		{name: "every key collides", hasher: HasherFunc[string](func(string) uint64 {
+			return 42
+		})},
+	}
+	for _, backend := range backends {
+		for _, hh := range hashers {
+			t.Run(backend.name+"/"+hh.name, func(t *testing.T) {
+				r := rand.New(rand.NewSource(1))
+				h := New[string, int](append(backendOpts[string](backend.chaining), WithHasher(hh.hasher))...)
+				want := map[string]int{}
+				for i := 0; i < 5000; i++ {
+					k := strconv.Itoa(r.Intn(500))
+					switch r.Intn(3) {
+					case 0, 1:
+						h.Put(k, i)
+						want[k] = i
+					case 2:
+						_, present := want[k]
+						if got := h.Delete(k); got != present {
+							t.Fatalf("HashTable.Delete(%q) = %v, want %v", k, got, present)
+						}
+						delete(want, k)
+					}
+					if h.Len() != len(want) {
+						t.Fatalf("HashTable.Len() = %d, want %d", h.Len(), len(want))
+					}
+				}
+				for k, v := range want {
+					if got, found := h.Get(k); !found || got != v {
+						t.Errorf("HashTable.Get(%q) = %d, %v, want %d, true", k, got, found, v)
+					}
+				}
+				checkTable(t, h)
+			})
+		}
+	}
+}
+
+func TestHashTable_IncrementalResize(t *testing.T) {
+	for _, backend := range backends {
+		for _, step := range []int{1, 2, 8} {
+			t.Run(backend.name+"/step "+strconv.Itoa(step), func(t *testing.T) {
+				h := New[int, int](append(backendOpts[int](backend.chaining), WithIncrementalResize[int](step))...)
+				want := map[int]int{}
+				// Fill until a resize starts and leaves the old table behind
+				for k := 0; h.old == nil; k++ {
+					h.Put(k, k)
+					want[k] = k
+				}
+				if h.table.len()+h.old.len() != len(want) {
+					t.Fatalf("entries were lost when the resize started")
+				}
+				// Overwrite everything, including keys still waiting in the old table, which
+				// must not be carried across later and clobber the new values
+				for k := range want {
+					h.Put(k, -k)
+					want[k] = -k
+				}
+
+				r := rand.New(rand.NewSource(int64(step)))
+				migrating := 0
+				for i := 0; i < 5000; i++ {
+					old, cursor := h.old, h.cursor
+					k := r.Intn(400)
+					switch r.Intn(4) {
+					case 0, 1:
+						h.Put(k, i)
+						want[k] = i
+					case 2:
+						_, present := want[k]
+						if got := h.Delete(k); got != present {
+							t.Fatalf("HashTable.Delete(%d) = %v, want %v", k, got, present)
+						}
+						delete(want, k)
+					case 3:
+						wantValue, present := want[k]
+						if got, found := h.Get(k); found != present || got != wantValue {
+							t.Fatalf("HashTable.Get(%d) = %d, %v, want %d, %v", k, got, found, wantValue, present)
+						}
+					}
+					if old != nil {
+						migrating++
+						// Each operation only moves step slots or buckets, unless it finished
+						// the migration or started another resize
+						if h.old == old && h.cursor-cursor > step {
+							t.Fatalf("operation moved %d slots or buckets, want at most %d", h.cursor-cursor, step)
+						}
+					}
+					if h.Len() != len(want) {
+						t.Fatalf("HashTable.Len() = %d, want %d", h.Len(), len(want))
+					}
+				}
+				if migrating == 0 {
+					t.Fatal("no operations ran while a migration was underway")
+				}
+				checkTable(t, h)
+
+				got := map[int]int{}
+				h.Range(func(k, v int) bool {
+					if _, seen := got[k]; seen {
+						t.Errorf("HashTable.Range() visited %d twice", k)
+					}
+					got[k] = v
+					return true
+				})
+				if !reflect.DeepEqual(got, want) {
+					t.Errorf("HashTable.Range() = %v, want %v", got, want)
+				}
+			})
+		}
+	}
+}
+
+func TestHashTable_RangeRepeatable(t *testing.T) {
+	// With an unseeded Hasher, tables given the same Puts visit them in the same order
+	for _, backend := range backends {
+		t.Run(backend.name, func(t *testing.T) {
+			var orders [2][]string
+			for i := range orders {
+				opts := append(backendOpts[string](backend.chaining), WithHasher[string](FNV1a[string]{}))
+				tableOf(opts, numbered(20)...).Range(func(k string, _ int) bool {
+					orders[i] = append(orders[i], k)
+					return true
+				})
+			}
+			if !reflect.DeepEqual(orders[0], orders[1]) {
+				t.Errorf("HashTable.Range() visited %v in one table but %v in the other", orders[0], orders[1])
+			}
+		})
+	}
+}
+
+func TestHashers(t *testing.T) {
+	tests := []struct {
+		name   string
+		hasher Hasher[string]
+		key    string
+		want   uint64
+	}{
+		{name: "fnv1a empty", hasher: FNV1a[string]{}, key: "", want: 0xcbf29ce484222325},
+		{name: "fnv1a a", hasher: FNV1a[string]{}, key: "a", want: 0xaf63dc4c8601ec8c},
+		{name: "fnv1a foobar", hasher: FNV1a[string]{}, key: "foobar", want: 0x85944171f73967e8},
+		{name: "xxhash empty", hasher: XXHash[string]{}, key: "", want: 0xef46db3751d8e999},
+		{name: "xxhash a", hasher: XXHash[string]{}, key: "a", want: 0xd24ec4f1a98c6e5b},
+		{name: "xxhash abc", hasher: XXHash[string]{}, key: "abc", want: 0x44bc2cf5ad770999},
+		{
+			name:   "xxhash long input",
+			hasher: XXHash[string]{},
+			key:    "Nobody inspects the spammish repetition",
+			want:   0xfbcea83c8a378bf1,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.hasher.Hash(tt.key); got != tt.want {
+				t.Errorf("Hash(%q) = %#x, want %#x", tt.key, got, tt.want)
+			}
+		})
+	}
+}
+
+// checkTable :: func :: Verifies the backend's bookkeeping, for the old table too when a
+// migration is underway. For Robin Hood tables every entry's probe distance must match where
+// it sits relative to its home slot, and no entry may be further from home than the one before
+// it allows. For chained tables every entry must be in the bucket its hash maps to. Both must
+// count their entries correctly.
+func checkTable[K comparable, V any](t *testing.T, h *HashTable[K, V]) {
+	t.Helper()
+	checkBackend(t, h.table)
+	if h.old != nil {
+		checkBackend(t, h.old)
+	}
+}
+
+func checkBackend[K comparable, V any](t *testing.T, tbl table[K, V]) {
+	t.Helper()
+	count := 0
+	switch table := tbl.(type) {
+	case *robinHood[K, V]:
+		for i, s := range table.slots {
+			if !s.used {
+				continue
+			}
+			count++
+			home := s.hash & table.mask
+			if dist := (uint64(i) - home) & table.mask; int(dist) != s.dist {
+				t.Fatalf("slot %d has probe distance %d, want %d", i, s.dist, dist)
+			}
+			prev := table.slots[(uint64(i)-1)&table.mask]
+			if s.dist > 0 && (!prev.used || prev.dist < s.dist-1) {
+				t.Fatalf("slot %d could have been placed closer to home", i)
+			}
+		}
+	case *chained[K, V]:
+		for i, bucket := range table.buckets {
+			if bucket == nil {
+				continue
+			}
+			for n := bucket.Head; n != nil; n = n.Next {
+				count++
+				if n.Value.hash&table.mask != uint64(i) {
+					t.Fatalf("bucket %d holds an entry belonging in bucket %d", i, n.Value.hash&table.mask)
+				}
+			}
+		}
+	}
+	if count != tbl.len() {
+		t.Fatalf("table holds %d entries but counts %d", count, tbl.len())
+	}
+}
+
+func tableOf(opts []Option[string], keys ...string) *HashTable[string, int] {
+	h := New[string, int](opts...)
+	for i, k := range keys {
+		h.Put(k, i)
+	}
+	return h
+}
+
+func numbered(n int) []string {
+	out := make([]string, n)
+	for i := range out {
+		out[i] = strconv.Itoa(i)
+	}
+	return out
+}
+
+func BenchmarkHashTable_Put(b *testing.B) {
+	for _, backend := range backends {
+		b.Run(backend.name, func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				h := New[int, int](backendOpts[int](backend.chaining)...)
+				for k := 0; k < 1000; k++ {
+					h.Put(k, k)
+				}
+			}
+		})
+	}
+}
+
+// BenchmarkHashTable_PutLatency :: func :: Reports the slowest single Put, which is where a
+// stop-the-world resize shows up and an incremental one shouldn't
+func BenchmarkHashTable_PutLatency(b *testing.B) {
+	modes := []struct {
+		name string
+		opts []Option[int]
+	}{
+		{name: "stop the world"},
+		{name: "incremental", opts: []Option[int]{WithIncrementalResize[int](4)}},
+	}
+	for _, mode := range modes {
+		b.Run(mode.name, func(b *testing.B) {
+			var worst time.Duration
+			for i := 0; i < b.N; i++ {
+				h := New[int, int](mode.opts...)
+				for k := 0; k < 100000; k++ {
+					start := time.Now()
+					h.Put(k, k)
+					if d := time.Since(start); d > worst {
+						worst = d
+					}
+				}
+			}
+			b.ReportMetric(float64(worst.Nanoseconds()), "worst-ns/put")
+		})
+	}
+}
+
+func BenchmarkMap_Put(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		m := map[int]int{}
+		for k := 0; k < 1000; k++ {
+			m[k] = k
+		}
+	}
+}
+
+func BenchmarkHashTable_Get(b *testing.B) {
+	for _, backend := range backends {
+		b.Run(backend.name, func(b *testing.B) {
+			h := New[int, int](backendOpts[int](backend.chaining)...)
+			for k := 0; k < 1000; k++ {
+				h.Put(k, k)
+			}
+			b.ResetTimer()
+			for i := 0; i < b.N; i++ {
+				h.Get(i % 2000)
+			}
+		})
+	}
+}
+
+func BenchmarkMap_Get(b *testing.B) {
+	m := map[int]int{}
+	for k := 0; k < 1000; k++ {
+		m[k] = k
+	}
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		_ = m[i%2000]
+	}
+}
+
+// BenchmarkHashTable_Adversarial :: func :: Compares the backends as the key distribution
+// gets worse, from well spread hashes down to keys that all share a handful of hashes
+func BenchmarkHashTable_Adversarial(b *testing.B) {
+	keys := numbered(1000)
+	distributions := []struct {
+		name   string
+		hasher Hasher[string]
+	}{
+		{name: "xxhash", hasher: XXHash[string]{}},
+		{name: "fnv1a", hasher: FNV1a[string]{}},
+		{name: "shared low bits", hasher: HasherFunc[string](func(k string) uint64 {
+			// Every hash lands on a multiple of 1024, so only the top bits tell buckets apart
+			return XXHash[string]{}.Hash(k) << 10
+		})},
+		{name: "sixty-four hashes", hasher: HasherFunc[string](func(k string) uint64 {
+			return XXHash[string]{}.Hash(k) & 0x3f
+		})},
+	}
+	for _, backend := range backends {
+		for _, d := range distributions {
+			b.Run(backend.name+"/"+d.name, func(b *testing.B) {
+				for i := 0; i < b.N; i++ {
+					h := New[string, int](append(backendOpts[string](backend.chaining), WithHasher(d.hasher))...)
+					for j, k := range keys {
+						h.Put(k, j)
+					}
+					for _, k := range keys {
+						h.Get(k)
+					}
+				}
+			})
+		}
+	}
+}
@@ -0,0 +1,135 @@
+package hashtable
+
+// robinHood :: struct :: Open addressing table using Robin Hood probing.
+// Every entry records how far it sits from the slot its hash maps to (its probe distance).
+// When an insert meets an entry that is closer to home than the one being placed, the two
+// swap, which keeps probe distances short and lets lookups stop early. Deletes shift the
+// rest of the cluster back a slot instead of leaving tombstones.
+type robinHood[K comparable, V any] struct {
+	slots []slot[K, V]
+	mask  uint64
+	count int
+}
+
+type slot[K comparable, V any] struct {
+	key   K
+	value V
+	hash  uint64
+	dist  int
+	used  bool
+}
+
+// newRobinHood :: func :: size must be a power of two
+func newRobinHood[K comparable, V any](size int) *robinHood[K, V] {
+	return &robinHood[K, V]{
+		slots: make([]slot[K, V], size),
+		mask:  uint64(size - 1),
+	}
+}
+
+func (t *robinHood[K, V]) len() int {
+	return t.count
+}
+
+func (t *robinHood[K, V]) cap() int {
+	return len(t.slots)
+}
+
+// find :: func :: returns the slot index holding key, or -1
+func (t *robinHood[K, V]) find(hash uint64, key K) int {
+	i := hash & t.mask
+	for dist := 0; ; dist++ {
+		s := &t.slots[i]
+		// An empty slot, or an entry closer to home than we've already probed,
+		// means key would have been placed before here
+		if !s.used || s.dist < dist {
+			return -1
+		}
+		if s.hash == hash && s.key == key {
+			return int(i)
+		}
+		i = (i + 1) & t.mask
+	}
+}
+
+func (t *robinHood[K, V]) get(hash uint64, key K) (V, bool) {
+	if i := t.find(hash, key); i >= 0 {
+		return t.slots[i].value, true
+	}
+	var zero V
+	return zero, false
+}
+
+// put :: func :: stores the entry, returning true if key wasn't already present.
+// The caller is responsible for keeping at least one slot free.
+func (t *robinHood[K, V]) put(hash uint64, key K, value V) bool {
+	entry := slot[K, V]{key: key, value: value, hash: hash, used: true}
+	i := hash & t.mask
+	for {
+		s := &t.slots[i]
+		if !s.used {
+			*s = entry
+			t.count++
+			return true
+		}
+		if s.hash == entry.hash && s.key == entry.key {
+			s.value = entry.value
+			return false
+		}
+		// Take from the rich: the resident is closer to home, so it moves on instead
+		if s.dist < entry.dist {
+			*s, entry = entry, *s
+		}
+		entry.dist++
+		i = (i + 1) & t.mask
+	}
+}
+
+func (t *robinHood[K, V]) delete(hash uint64, key K) bool {
+	i := t.find(hash, key)
+	if i < 0 {
+		return false
+	}
+	t.removeAt(uint64(i))
+	return true
+}
+
+// removeAt :: func :: empties slot i and shifts the following entries back until one
+// is found that is already in its home slot (or the slot is empty)
+func (t *robinHood[K, V]) removeAt(i uint64) {
+	for {
+		next := (i + 1) & t.mask
+		if !t.slots[next].used || t.slots[next].dist == 0 {
+			t.slots[i] = slot[K, V]{}
+			break
+		}
+		t.slots[i] = t.slots[next]
+		t.slots[i].dist--
+		i = next
+	}
+	t.count--
+}
+
+// pop :: func :: removes the entry in slot i. The backward shift may pull the next entry
+// of the cluster into slot i, so callers drain a slot by popping until it reports false.
+// Once drained, nothing left in the table probes through slot i.
+func (t *robinHood[K, V]) pop(i int) (uint64, K, V, bool) {
+	s := t.slots[i]
+	if !s.used {
+		return 0, s.key, s.value, false
+	}
+	t.removeAt(uint64(i))
+	return s.hash, s.key, s.value, true
+}
+
+// each :: func :: visits entries in slot order until f returns false
+func (t *robinHood[K, V]) each(f func(hash uint64, key K, value V) bool) bool {
+	for i := range t.slots {
+		if s := &t.slots[i]; s.used {
+			if !f(s.hash, s.key, s.value) {
+				return false
+			}
+		}
+	}
+	return true
+}
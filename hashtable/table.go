@@ -0,0 +1,20 @@
+package hashtable
+
+// table :: interface :: Storage behind a HashTable. Both backends are fixed-size; the
+// HashTable decides when to replace one with a bigger one. Hashes are computed once by
+// the HashTable and passed in, so a table never needs to know about the Hasher.
+type table[K comparable, V any] interface {
+	// len :: number of entries stored
+	len() int
+	// cap :: number of slots or buckets, which the load factor is measured against
+	cap() int
+	get(hash uint64, key K) (V, bool)
+	// put :: returns true if key wasn't already present
+	put(hash uint64, key K, value V) bool
+	delete(hash uint64, key K) bool
+	// each :: returns false if f stopped the walk early
+	each(f func(hash uint64, key K, value V) bool) bool
+	// pop :: removes and returns one entry stored in slot or bucket i, reporting false once
+	// there's nothing left there. Used to drain an old table during an incremental resize.
+	pop(i int) (hash uint64, key K, value V, ok bool)
+}
@@ -0,0 +1,179 @@
+package lfu
+
+import (
+	"go-datastructures/hashtable"
+	"go-datastructures/linkedlist"
+)
+
+// Cache :: struct :: Fixed-capacity cache that evicts the least frequently used entry to make room,
+// breaking ties by evicting the least recently used of them.
+//
+// Every operation is O(1). Entries with the same use count share a bucket, a DoublyLinkedList
+// ordered from most to least recently used, and the buckets themselves sit in a DoublyLinkedList
+// in ascending order of count. A use moves an entry from its bucket to the head of the next one
+// along, creating that bucket if the counts aren't adjacent; an eviction takes the tail of the first
+// bucket. A HashTable from each key to its entry's Node means nothing is ever searched for.
+//
+//	buckets: [1: c b] <-> [2: a] <-> [5: d e]
+type Cache[K comparable, V any] struct {
+	capacity int
+	buckets  *linkedlist.DoublyLinkedList[*bucket[K, V]]
+	index    *hashtable.HashTable[K, *linkedlist.DoubleNode[entry[K, V]]]
+	onEvict  func(key K, value V)
+}
+
+// bucket :: struct :: the entries used exactly count times
+type bucket[K comparable, V any] struct {
+	count   int
+	entries *linkedlist.DoublyLinkedList[entry[K, V]]
+}
+
+// entry :: struct :: list value, pointing back at the Node of the bucket it's in
+type entry[K comparable, V any] struct {
+	key    K
+	value  V
+	bucket *linkedlist.DoubleNode[*bucket[K, V]]
+}
+
+// New :: func :: Returns a pointer to a new Cache holding up to capacity entries, configured by opts
+func New[K comparable, V any](capacity int, opts ...Option[K, V]) *Cache[K, V] {
+	if capacity < 1 {
+		panic("lfu: capacity must be at least 1")
+	}
+	var c config[K, V]
+	for _, opt := range opts {
+		opt(&c)
+	}
+	cache := &Cache[K, V]{
+		capacity: capacity,
+		buckets:  linkedlist.NewDoublyLinked[*bucket[K, V]](),
+		index:    hashtable.New[K, *linkedlist.DoubleNode[entry[K, V]]](),
+		onEvict:  c.onEvict,
+	}
+	return cache
+}
+
+// Get :: func :: Returns the value stored under key, and whether there was one, counting it as a use
+func (c *Cache[K, V]) Get(key K) (V, bool) {
+	node, found := c.index.Get(key)
+	if !found {
+		var zero V
+		return zero, false
+	}
+	c.use(node)
+	return node.Value.value, true
+}
+
+// Peek :: func :: Returns the value stored under key, and whether there was one, without counting it as a use
+func (c *Cache[K, V]) Peek(key K) (V, bool) {
+	node, found := c.index.Get(key)
+	if !found {
+		var zero V
+		return zero, false
+	}
+	return node.Value.value, true
+}
+
+// Put :: func :: Stores value under key, evicting the least frequently used entry if the Cache is full.
+// A new entry starts with a count of one; replacing a value counts as a use of it.
+func (c *Cache[K, V]) Put(key K, value V) {
+	if node, found := c.index.Get(key); found {
+		node.Value.value = value
+		c.use(node)
+		return
+	}
+	if c.index.Len() == c.capacity {
+		c.evict()
+	}
+	first := c.buckets.Head
+	if first == nil || first.Value.count != 1 {
+		first = c.buckets.AddHead(newBucket[K, V](1))
+	}
+	c.index.Put(key, first.Value.entries.AddHead(entry[K, V]{key: key, value: value, bucket: first}))
+}
+
+// Remove :: func :: Removes key from the Cache, returning false if it wasn't present.
+// OnEvict isn't called for entries removed this way.
+func (c *Cache[K, V]) Remove(key K) bool {
+	node, found := c.index.Get(key)
+	if !found {
+		return false
+	}
+	c.drop(node)
+	return true
+}
+
+// Len :: func :: Returns the number of entries in the Cache
+func (c *Cache[K, V]) Len() int {
+	return c.index.Len()
+}
+
+// Count :: func :: Returns the number of times the entry under key has been used, 0 if it isn't cached
+func (c *Cache[K, V]) Count(key K) int {
+	node, found := c.index.Get(key)
+	if !found {
+		return 0
+	}
+	return node.Value.bucket.Value.count
+}
+
+// use :: func :: moves node up from its bucket to the head of the one for the next count
+func (c *Cache[K, V]) use(node *linkedlist.DoubleNode[entry[K, V]]) {
+	current := node.Value.bucket
+	next := current.Next
+	if next == nil || next.Value.count != current.Value.count+1 {
+		next, _ = c.buckets.InsertAfter(current, newBucket[K, V](current.Value.count+1))
+	}
+	e := node.Value
+	e.bucket = next
+	c.unlink(node)
+	c.index.Put(e.key, next.Value.entries.AddHead(e))
+}
+
+// evict :: func :: drops the least recently used entry of the lowest count and tells OnEvict about it
+func (c *Cache[K, V]) evict() {
+	victim := c.buckets.Head.Value.entries.Tail
+	c.drop(victim)
+	if c.onEvict != nil {
+		c.onEvict(victim.Value.key, victim.Value.value)
+	}
+}
+
+// drop :: func :: takes node out of its bucket and the index
+func (c *Cache[K, V]) drop(node *linkedlist.DoubleNode[entry[K, V]]) {
+	c.unlink(node)
+	c.index.Delete(node.Value.key)
+}
+
+// unlink :: func :: takes node out of its bucket, and the bucket out of the Cache if that empties it
+func (c *Cache[K, V]) unlink(node *linkedlist.DoubleNode[entry[K, V]]) {
+	b := node.Value.bucket
+	b.Value.entries.Unlink(node)
+	if b.Value.entries.IsEmpty() {
+		c.buckets.Unlink(b)
+	}
+}
+
+func newBucket[K comparable, V any](count int) *bucket[K, V] {
+	return &bucket[K, V]{
+		count: count,
+		// Entries are only ever found through the index, but compare keys in case anyone asks the list
+		entries: linkedlist.NewDoublyLinkedFunc(func(a, b entry[K, V]) bool {
+			return a.key == b.key
+		}),
+	}
+}
+
+// Option :: func :: Configures a Cache created with New
+type Option[K comparable, V any] func(*config[K, V])
+
+type config[K comparable, V any] struct {
+	onEvict func(key K, value V)
+}
+
+// WithOnEvict :: func :: Calls f with each entry the Cache evicts to make room
+func WithOnEvict[K comparable, V any](f func(key K, value V)) Option[K, V] {
+	return func(c *config[K, V]) {
+		c.onEvict = f
+	}
+}
@@ -0,0 +1,255 @@
+package lfu
+
+import (
+	"math/rand"
+	"reflect"
+	"slices"
+	"testing"
+)
+
+// counts :: func :: returns the Cache's keys grouped by use count, each group from most to least
+// recently used, checking the buckets and the index agree with each other
+func counts[K comparable, V any](t *testing.T, c *Cache[K, V]) map[int][]K {
+	t.Helper()
+	out := map[int][]K{}
+	n, last := 0, 0
+	for bt := c.buckets.Iterator(); bt.Next(); {
+		b := bt.Value()
+		if b.count <= last {
+			t.Errorf("bucket for count %d follows the one for %d", b.count, last)
+		}
+		if b.entries.IsEmpty() {
+			t.Errorf("bucket for count %d is empty", b.count)
+		}
+		last = b.count
+		for et := b.entries.Iterator(); et.Next(); {
+			e := et.Value()
+			out[b.count] = append(out[b.count], e.key)
+			n++
+			if node, found := c.index.Get(e.key); !found || node.Value.key != e.key || node.Value.bucket.Value != b {
+				t.Errorf("key %v is in the bucket for count %d but not the index", e.key, b.count)
+			}
+		}
+	}
+	if c.index.Len() != n || c.Len() != n {
+		t.Errorf("index holds %d keys and Len() = %d, but the buckets hold %d", c.index.Len(), c.Len(), n)
+	}
+	return out
+}
+
+func TestCache(t *testing.T) {
+	tests := []struct {
+		name     string
+		capacity int
+		ops      func(c *Cache[string, int])
+		want     map[int][]string
+		evicted  []string
+	}{
+		{
+			name:     "puts up to capacity",
+			capacity: 3,
+			ops: func(c *Cache[string, int]) {
+				c.Put("a", 1)
+				c.Put("b", 2)
+				c.Put("c", 3)
+			},
+			want: map[int][]string{1: {"c", "b", "a"}},
+		},
+		{
+			name:     "ties are broken by recency",
+			capacity: 2,
+			ops: func(c *Cache[string, int]) {
+				c.Put("a", 1)
+				c.Put("b", 2)
+				c.Put("c", 3)
+			},
+			want:    map[int][]string{1: {"c", "b"}},
+			evicted: []string{"a"},
+		},
+		{
+			name:     "least frequently used is evicted",
+			capacity: 2,
+			ops: func(c *Cache[string, int]) {
+				c.Put("a", 1)
+				c.Put("b", 2)
+				c.Get("a")
+				c.Get("b")
+				c.Get("a")
+				c.Put("c", 3)
+			},
+			want:    map[int][]string{1: {"c"}, 3: {"a"}},
+			evicted: []string{"b"},
+		},
+		{
+			name:     "peek doesn't count",
+			capacity: 2,
+			ops: func(c *Cache[string, int]) {
+				c.Put("a", 1)
+				c.Put("b", 2)
+				c.Peek("a")
+				c.Put("c", 3)
+			},
+			want:    map[int][]string{1: {"c", "b"}},
+			evicted: []string{"a"},
+		},
+		{
+			name:     "replacing a value counts",
+			capacity: 2,
+			ops: func(c *Cache[string, int]) {
+				c.Put("a", 1)
+				c.Put("b", 2)
+				c.Put("a", 10)
+				c.Put("c", 3)
+			},
+			want:    map[int][]string{1: {"c"}, 2: {"a"}},
+			evicted: []string{"b"},
+		},
+		{
+			name:     "counts needn't be adjacent",
+			capacity: 3,
+			ops: func(c *Cache[string, int]) {
+				c.Put("a", 1)
+				c.Put("b", 2)
+				c.Get("a")
+				c.Get("a")
+				c.Get("b")
+				c.Put("c", 3)
+				c.Get("b")
+				c.Get("b")
+			},
+			want: map[int][]string{1: {"c"}, 3: {"a"}, 4: {"b"}},
+		},
+		{
+			name:     "removed entries aren't evicted",
+			capacity: 2,
+			ops: func(c *Cache[string, int]) {
+				c.Put("a", 1)
+				c.Put("b", 2)
+				c.Get("b")
+				c.Remove("b")
+				c.Remove("z")
+				c.Put("c", 3)
+			},
+			want: map[int][]string{1: {"c", "a"}},
+		},
+		{
+			name:     "capacity of one",
+			capacity: 1,
+			ops: func(c *Cache[string, int]) {
+				c.Put("a", 1)
+				c.Get("a")
+				c.Put("b", 2)
+				c.Put("c", 3)
+			},
+			want:    map[int][]string{1: {"c"}},
+			evicted: []string{"a", "b"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var evicted []string
+			c := New[string, int](tt.capacity, WithOnEvict(func(key string, _ int) {
+				evicted = append(evicted, key)
+			}))
+			tt.ops(c)
+			if got := counts(t, c); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Cache holds %v, want %v", got, tt.want)
+			}
+			if !reflect.DeepEqual(evicted, tt.evicted) {
+				t.Errorf("OnEvict called with %v, want %v", evicted, tt.evicted)
+			}
+		})
+	}
+}
+
+func TestCache_GetPeekRemove(t *testing.T) {
+	c := New[string, int](2)
+	c.Put("a", 1)
+	c.Put("a", 2)
+	for name, get := range map[string]func(string) (int, bool){"Get": c.Get, "Peek": c.Peek} {
+		if got, found := get("a"); got != 2 || !found {
+			t.Errorf("%s(a) = %v, %v, want 2, true", name, got, found)
+		}
+		if got, found := get("z"); got != 0 || found {
+			t.Errorf("%s(z) = %v, %v, want 0, false", name, got, found)
+		}
+	}
+	if got := c.Count("a"); got != 3 {
+		t.Errorf("Count(a) = %d, want 3", got)
+	}
+	if !c.Remove("a") || c.Remove("a") {
+		t.Errorf("Remove(a) should succeed once")
+	}
+	if _, found := c.Get("a"); found || c.Len() != 0 || c.Count("a") != 0 {
+		t.Errorf("Get(a) found a removed entry, Len() = %d", c.Len())
+	}
+}
+
+func TestNew_ZeroCapacity(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Errorf("New() did not panic")
+		}
+	}()
+	New[string, int](0)
+}
+
+func TestCache_Random(t *testing.T) {
+	const capacity = 16
+	rng := rand.New(rand.NewSource(1))
+	c := New[int, int](capacity)
+	// The reference model: each key's value and use count, and keys from most to least recently used
+	values, uses := map[int]int{}, map[int]int{}
+	var recent []int
+	touch := func(key int) {
+		uses[key]++
+		recent = slices.DeleteFunc(recent, func(k int) bool { return k == key })
+		recent = append([]int{key}, recent...)
+	}
+	drop := func(key int) {
+		delete(values, key)
+		delete(uses, key)
+		recent = slices.DeleteFunc(recent, func(k int) bool { return k == key })
+	}
+	for i := 0; i < 5000; i++ {
+		key := rng.Intn(40)
+		switch rng.Intn(4) {
+		case 0:
+			got, found := c.Get(key)
+			want, wantFound := values[key]
+			if got != want || found != wantFound {
+				t.Fatalf("Get(%d) = %v, %v, want %v, %v", key, got, found, want, wantFound)
+			}
+			if found {
+				touch(key)
+			}
+		case 1:
+			removed := c.Remove(key)
+			if _, want := values[key]; removed != want {
+				t.Fatalf("Remove(%d) = %v, want %v", key, removed, want)
+			}
+			drop(key)
+		default:
+			if _, found := values[key]; !found && len(values) == capacity {
+				// The least recently used of the keys with the fewest uses
+				victim := recent[len(recent)-1]
+				for _, k := range slices.Backward(recent) {
+					if uses[k] < uses[victim] {
+						victim = k
+					}
+				}
+				drop(victim)
+			}
+			c.Put(key, i)
+			values[key] = i
+			touch(key)
+		}
+		want := map[int][]int{}
+		for _, k := range recent {
+			want[uses[k]] = append(want[uses[k]], k)
+		}
+		if got := counts(t, c); !reflect.DeepEqual(got, want) {
+			t.Fatalf("after operation %d Cache holds %v, want %v", i, got, want)
+		}
+	}
+}
@@ -1,30 +1,41 @@
 package linkedlist
 
-import (
-	"errors"
-	"reflect"
-
-	"go-datastructures/model"
-)
-
 // DoublyLinkedList - struct - DoublyLinkedList
-type DoublyLinkedList struct {
-	Current *DoubleNode
-	Head    *DoubleNode
-	Tail    *DoubleNode
+type DoublyLinkedList[T any] struct {
+	// Current is the position of the HasNext/HasPrevious walk over the list.
+	//
+	// Deprecated: only HasNext and HasPrevious move Current, so it's shared by every
+	// loop over the list. Use a DoubleIterator instead.
+	Current *DoubleNode[T]
+	Head    *DoubleNode[T]
+	Tail    *DoubleNode[T]
+	// size counts the Nodes added and removed through the list's methods,
+	// Nodes linked onto Head or Tail by hand aren't included, see shrink
+	size  int
+	equal EqualFunc[T]
 }
 
-// DoubleNode :: struct :: Container struct for model.Object nodes
-type DoubleNode struct {
-	Value    model.Object
-	Next     *DoubleNode
-	Previous *DoubleNode
+// DoubleNode :: struct :: Container struct for list values
+type DoubleNode[T any] struct {
+	Value    T
+	Next     *DoubleNode[T]
+	Previous *DoubleNode[T]
+	// list is the list the Node was added to, nil once it's been removed,
+	// so the node-handle methods can refuse Nodes that aren't theirs
+	list *DoublyLinkedList[T]
 }
 
 // NewDoublyLinked :: func :: Returns a pointer to a new DoublyLinkedList
-func NewDoublyLinked(values ...string) (l *DoublyLinkedList) {
-	l = &DoublyLinkedList{}
-	if values != nil && len(values) > 0 {
+// whose values are compared with ==
+func NewDoublyLinked[T comparable](values ...T) (l *DoublyLinkedList[T]) {
+	return NewDoublyLinkedFunc(comparableEqual[T], values...)
+}
+
+// NewDoublyLinkedFunc :: func :: Returns a pointer to a new DoublyLinkedList
+// whose values are compared with the supplied EqualFunc
+func NewDoublyLinkedFunc[T any](equal EqualFunc[T], values ...T) (l *DoublyLinkedList[T]) {
+	l = &DoublyLinkedList[T]{equal: equal}
+	if len(values) > 0 {
 		l.AddNode(BuildDoubleNodes(values)...)
 	}
 	return l
@@ -35,64 +46,82 @@ func NewDoublyLinked(values ...string) (l *DoublyLinkedList) {
 // - Previous Head.Previous points to new Head
 // - New Node being added to the front has the Next point towards the previous Head
 // - List then accepts the new node as the current Head
-func (l *DoublyLinkedList) AddHead(obj model.Object) {
-	newItem := &DoubleNode{
+// The new node is returned, as a handle for the node-handle methods.
+func (l *DoublyLinkedList[T]) AddHead(obj T) *DoubleNode[T] {
+	newItem := &DoubleNode[T]{
 		Value: obj,
 		Next:  l.Head,
+		list:  l,
 	}
-	oldHead := l.Head
-	if oldHead != nil {
+	if l.Head != nil {
 		l.Head.Previous = newItem
-		if oldHead.Next == l.Tail {
-			l.Tail = l.Head
-		}
-	}
-	l.Head = newItem
-	// If this is the only item, it's also the tail
-	if l.Tail == nil {
+	} else {
+		// If this is the only item, it's also the tail
 		l.Tail = newItem
 	}
+	l.Head = newItem
+	l.size++
+	return newItem
 }
 
 // AddTail :: func :: Adds a new node to the LinkedList
 // - Previous Tail's Next is updated to new Node (in constructor)
 // - New Node.Previous points back to the old Tail
 // - LinkedList updates current tail as the new Node
-func (l *DoublyLinkedList) AddTail(obj model.Object) {
-	newItem := &DoubleNode{
+// The new node is returned, as a handle for the node-handle methods.
+func (l *DoublyLinkedList[T]) AddTail(obj T) *DoubleNode[T] {
+	newItem := &DoubleNode[T]{
 		Value:    obj,
 		Previous: l.Tail,
+		list:     l,
 	}
 	// Looks weird, but updating the Next reference to to previous tail
 	// before the List's tail is actually updated.
 	if l.Tail != nil {
 		l.Tail.Next = newItem
+	} else {
+		// Empty list, the new Node is also the Head
+		l.Head = newItem
 	}
 	// Update the List's Tail to be the new Node
 	l.Tail = newItem
+	l.size++
+	return newItem
+}
+
+// Len :: func :: Returns the number of values in the list, without walking it.
+// Only values added through the list's methods are counted, not Nodes linked onto Head or Tail by hand.
+func (l *DoublyLinkedList[T]) Len() int {
+	return l.size
+}
+
+// IsEmpty :: func :: Reports whether the list holds no values
+func (l *DoublyLinkedList[T]) IsEmpty() bool {
+	return l.Head == nil
+}
+
+// shrink :: func :: Counts a Node removed from the list. Removing a Node that was linked by hand,
+// and so never counted, leaves size at 0 rather than taking it negative.
+func (l *DoublyLinkedList[T]) shrink() {
+	if l.size > 0 {
+		l.size--
+	}
 }
 
 // Find :: func :: find an object in the list
-func (l *DoublyLinkedList) Find(obj model.Object) (model.Object, bool) {
+func (l *DoublyLinkedList[T]) Find(obj T) (T, bool) {
 	if node, found := l.FindNode(obj); found {
 		return node.Value, true
 	}
-	return model.Object{}, false
+	var zero T
+	return zero, false
 }
 
-func (l *DoublyLinkedList) FindNode(obj model.Object) (*DoubleNode, bool) {
-	l.Current = l.Head
-	if l.Current == nil {
-		return nil, false
-	}
-	// Check first element manually, since HasNext will advance Current
-	if firstMatch := reflect.DeepEqual(l.Current.Value, obj); firstMatch {
-		return l.Current, firstMatch
-	}
-	// Iterate through rest of list
-	for l.HasNext() {
-		if reflect.DeepEqual(l.Current.Value, obj) {
-			return l.Current, true
+// FindNode :: func :: Find the first DoubleNode holding a value equal to obj
+func (l *DoublyLinkedList[T]) FindNode(obj T) (*DoubleNode[T], bool) {
+	for node := l.Head; node != nil; node = node.Next {
+		if equals(l.equal, node.Value, obj) {
+			return node, true
 		}
 	}
 	return nil, false
@@ -101,35 +130,45 @@ func (l *DoublyLinkedList) FindNode(obj model.Object) (*DoubleNode, bool) {
 // Remove :: func :: find an object in the list
 // This implementation gets to be simpler because the reference to the Previous
 // is kept in the DoubleNode struct.
-func (l *DoublyLinkedList) Remove(obj model.Object) error {
+func (l *DoublyLinkedList[T]) Remove(obj T) error {
 	node, found := l.FindNode(obj)
 	if !found {
-		return errors.New("object not in list")
-	}
-	if node == l.Head {
-		// Removing the Head
-		l.Head = node.Next
-		if l.Head != nil {
-			l.Head.Previous = nil
-		} else {
-			// This was the last item in the list
-			l.Tail = nil
-		}
-	} else if node == l.Tail {
-		// Removing the Tail
-		l.Tail = node.Previous
-		l.Tail.Next = nil
-	} else {
-		// Removing a link
-		node.Previous.Next = node.Next
-		node.Next.Previous = node.Previous
+		return ErrNotFound
 	}
-	l.Current = nil
+	l.unlink(node)
 	return nil
 }
 
+// RemoveHead :: func :: Removes the value at the Head of the list, returning it.
+// Unlike Remove it doesn't compare values, so it takes the Head even when another Node holds an equal value.
+func (l *DoublyLinkedList[T]) RemoveHead() (T, error) {
+	if l.Head == nil {
+		var zero T
+		return zero, ErrEmpty
+	}
+	head := l.Head
+	l.unlink(head)
+	return head.Value, nil
+}
+
+// RemoveTail :: func :: Removes the value at the Tail of the list, returning it
+func (l *DoublyLinkedList[T]) RemoveTail() (T, error) {
+	if l.Tail == nil {
+		var zero T
+		return zero, ErrEmpty
+	}
+	tail := l.Tail
+	l.unlink(tail)
+	return tail.Value, nil
+}
+
 // HasNext :: func :: returns true if the next Node is not nil
-func (l *DoublyLinkedList) HasNext() bool {
+// Since this is being use to iterate over lists, it also
+// advances the Current marker.
+//
+// Deprecated: every HasNext loop over a list shares its Current marker, so two loops
+// can't walk the same list at once. Use a DoubleIterator instead.
+func (l *DoublyLinkedList[T]) HasNext() bool {
 	if l.Current == nil {
 		l.Current = l.Head
 		if l.Current != nil {
@@ -145,7 +184,9 @@ func (l *DoublyLinkedList) HasNext() bool {
 }
 
 // HasPrevious :: func :: returns true if the previous Node is not nil
-func (l *DoublyLinkedList) HasPrevious() bool {
+//
+// Deprecated: HasPrevious shares the Current marker with HasNext. Use a DoubleIterator instead.
+func (l *DoublyLinkedList[T]) HasPrevious() bool {
 	// Check Current/Tail to verify the list has Nodes
 	// Check if Current isn't set
 	if l.Current == nil {
@@ -163,59 +204,57 @@ func (l *DoublyLinkedList) HasPrevious() bool {
 	return l.Current.Previous != nil
 }
 
-// AddNode :: func :: Helper function to build list or add new nodes to existing list
-func (l *DoublyLinkedList) AddNode(n ...*DoubleNode) {
-	// Determine position in list before iterating
-	if l.Head != nil && l.Tail != nil {
-		l.checkHeadTail()
-		l.Current = l.Tail
-		// If Head and Tail are set but not connected
-	} else if l.Current == nil && l.Head != nil {
-		l.checkHeadTail()
-		// Advance to last item in list
-		for l.HasNext() {
-		}
-		if l.Tail == nil {
-			l.Tail = l.Current
-			l.Current = l.Tail
-		}
-	} else if l.Head == nil && l.Tail != nil {
+// AddNode :: func :: Helper function to build list or add new nodes to existing list.
+// A Node that's already in a list, this one or another, is unlinked from it first and so moved to the Tail.
+func (l *DoublyLinkedList[T]) AddNode(n ...*DoubleNode[T]) {
+	// If Head and Tail are set but not connected
+	l.checkHeadTail()
+	if l.Head == nil {
 		l.Head = l.Tail
-		l.Current = l.Head
 	}
-	if l.Current != nil {
-		// Advance to last link
-		for l.HasNext() {
-
-		}
+	// Advance to last link, the Tail may not have been set on a list built by hand
+	last := l.Tail
+	if last == nil {
+		last = l.Head
 	}
-	// Current has been set, iterate to add Nodes
-	for i, node := range n {
-		if i == 0 && l.Current == nil {
-			// Set current if list is empty
-			l.Current = node
+	for last != nil && last.Next != nil {
+		last = last.Next
+	}
+	for _, node := range n {
+		if node.list != nil {
+			if node == last {
+				last = node.Previous
+			}
+			node.list.unlink(node)
+			node.Previous, node.Next = nil, nil
+		}
+		if last == nil {
+			// List is empty, the first Node becomes the Head
 			l.Head = node
-			continue
 		} else {
-			node.Previous = l.Current
-			l.Current.Next = node
-			l.Current = node
+			node.Previous = last
+			last.Next = node
 		}
+		node.list = l
+		last = node
 	}
-	l.Tail = l.Current
+	l.Tail = last
+	l.size += len(n)
 }
 
 // BuildDoubleNodes :: func :: Helper function to wrap values into Nodes
-func BuildDoubleNodes(in []string) []*DoubleNode {
-	var out []*DoubleNode
+func BuildDoubleNodes[T any](in []T) []*DoubleNode[T] {
+	var out []*DoubleNode[T]
 	for _, val := range in {
-		out = append(out, &DoubleNode{Value: model.Object{Value: val}})
+		out = append(out, &DoubleNode[T]{Value: val})
 	}
 	return out
 }
 
-func (l DoublyLinkedList) checkHeadTail() {
-	if l.Head != nil && l.Head.Next == nil && l.Tail != nil {
+// checkHeadTail :: func :: links up a Head and Tail that were set by hand without pointing at each other.
+// On a single-node list they're the same Node, which mustn't be linked to itself.
+func (l DoublyLinkedList[T]) checkHeadTail() {
+	if l.Head != nil && l.Head.Next == nil && l.Tail != nil && l.Head != l.Tail {
 		l.Head.Next = l.Tail
 		l.Tail.Previous = l.Head
 	}
@@ -1,14 +1,14 @@
 package linkedlist
 
 import (
-	"go-datastructures/model"
 	"reflect"
+	"slices"
 	"testing"
 )
 
 func TestDoublyLinkedList_Find(t *testing.T) {
 	type args struct {
-		obj model.Object
+		obj string
 	}
 	tests := []struct {
 		name   string
@@ -57,9 +57,9 @@ func TestDoublyLinkedList_Find(t *testing.T) {
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			l := &DoublyLinkedList{}
+			l := &DoublyLinkedList[string]{}
 			l.AddNode(BuildDoubleNodes(tt.values)...)
-			obj := model.Object{Value: tt.search}
+			obj := tt.search
 			_, found := l.Find(obj)
 			if found != tt.found {
 				t.Error("DoublyLinkedList.Find() item not found in list")
@@ -70,9 +70,9 @@ func TestDoublyLinkedList_Find(t *testing.T) {
 
 func TestDoublyLinkedList_AddHead(t *testing.T) {
 	type fields struct {
-		Current *DoubleNode
-		Head    *DoubleNode
-		Tail    *DoubleNode
+		Current *DoubleNode[string]
+		Head    *DoubleNode[string]
+		Tail    *DoubleNode[string]
 	}
 	tests := []struct {
 		name   string
@@ -82,19 +82,19 @@ func TestDoublyLinkedList_AddHead(t *testing.T) {
 		{
 			name: "head added successfully",
 			fields: fields{
-				Head: &DoubleNode{Value: model.Object{Value: "first"}},
+				Head: &DoubleNode[string]{Value: "first"},
 			},
 			value: "newFirst",
 		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			l := &DoublyLinkedList{
+			l := &DoublyLinkedList[string]{
 				Current: tt.fields.Current,
 				Head:    tt.fields.Head,
 				Tail:    tt.fields.Tail,
 			}
-			obj := model.Object{Value: tt.value}
+			obj := tt.value
 			l.AddHead(obj)
 			if !reflect.DeepEqual(l.Head.Value, obj) {
 				t.Errorf("DoublyLinkedList.AddHead() Head doens't match expected | head: %v | expected: %v", l.Head.Value, obj)
@@ -105,28 +105,33 @@ func TestDoublyLinkedList_AddHead(t *testing.T) {
 
 func TestDoublyLinkedList_AddTail(t *testing.T) {
 	type fields struct {
-		Current *DoubleNode
-		Head    *DoubleNode
-		Tail    *DoubleNode
+		Current *DoubleNode[string]
+		Head    *DoubleNode[string]
+		Tail    *DoubleNode[string]
 	}
 	tests := []struct {
 		name      string
-		nodes     []*DoubleNode
+		nodes     []*DoubleNode[string]
 		nodesHead string
 		nodesTail string
 		wantHead  string
 		wantTail  string
-		wantNodes []*DoubleNode
+		wantNodes []*DoubleNode[string]
 		fields    fields
 		value     string
 	}{
 		{
 			name: "tail added successfully",
 			fields: fields{
-				Tail: &DoubleNode{Value: model.Object{Value: "last"}},
+				Tail: &DoubleNode[string]{Value: "last"},
 			},
 			value: "newLast",
 		},
+		{
+			name:     "tail added to empty list is also the head",
+			value:    "only",
+			wantHead: "only",
+		},
 		{
 			name: "tail replaces existing successfully, links stay connected",
 			nodes: BuildDoubleNodes([]string{
@@ -143,7 +148,7 @@ func TestDoublyLinkedList_AddTail(t *testing.T) {
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			l := &DoublyLinkedList{
+			l := &DoublyLinkedList[string]{
 				Current: tt.fields.Current,
 				Head:    tt.fields.Head,
 				Tail:    tt.fields.Tail,
@@ -152,13 +157,16 @@ func TestDoublyLinkedList_AddTail(t *testing.T) {
 				l.AddNode(tt.nodes...)
 			}
 
-			obj := model.Object{Value: tt.value}
+			obj := tt.value
 			l.AddTail(obj)
 			if !reflect.DeepEqual(l.Tail.Value, obj) {
 				t.Errorf("DoublyLinkedList.AddTail() Tail doens't match expected | tail: %v | expected: %v", l.Tail.Value, obj)
 			}
+			if tt.wantHead != "" && (l.Head == nil || l.Head.Value != tt.wantHead) {
+				t.Errorf("DoublyLinkedList.AddTail() Head = %v, want %v", l.Head, tt.wantHead)
+			}
 			if tt.nodes != nil {
-				wantList := &DoublyLinkedList{}
+				wantList := &DoublyLinkedList[string]{}
 				wantList.AddNode(tt.wantNodes...)
 
 				if reflect.DeepEqual(l, wantList) {
@@ -171,14 +179,15 @@ func TestDoublyLinkedList_AddTail(t *testing.T) {
 
 func TestDoublyLinkedList_addNode(t *testing.T) {
 	type fields struct {
-		Current *DoubleNode
-		Head    *DoubleNode
-		Tail    *DoubleNode
+		Current *DoubleNode[string]
+		Head    *DoubleNode[string]
+		Tail    *DoubleNode[string]
 	}
 	tests := []struct {
 		name   string
 		fields fields
 		values []string
+		want   []string
 	}{
 		{
 			name: "list is built with all nodes connected",
@@ -188,13 +197,14 @@ func TestDoublyLinkedList_addNode(t *testing.T) {
 				"third",
 				"fourth",
 			},
+			want: []string{"first", "second", "third", "fourth"},
 		},
 		{
 			name: "existing list without current set",
 			fields: fields{
 				Current: nil,
-				Head: &DoubleNode{
-					Value: model.Object{Value: "first"},
+				Head: &DoubleNode[string]{
+					Value: "first",
 				},
 			},
 			values: []string{
@@ -202,67 +212,86 @@ func TestDoublyLinkedList_addNode(t *testing.T) {
 				"third",
 				"fourth",
 			},
+			want: []string{"first", "second", "third", "fourth"},
 		},
 		{
 			name: "existing list with set head and tail",
 			fields: fields{
 				Current: nil,
-				Head: &DoubleNode{
-					Value: model.Object{Value: "first"},
+				Head: &DoubleNode[string]{
+					Value: "first",
 				},
-				Tail: &DoubleNode{
-					Value: model.Object{Value: "second"},
+				Tail: &DoubleNode[string]{
+					Value: "second",
 				},
 			},
 			values: []string{
 				"third",
 				"fourth",
 			},
+			want: []string{"first", "second", "third", "fourth"},
 		},
 		{
 			name: "existing list with unset head and tail",
 			fields: fields{
 				Current: nil,
-				Tail: &DoubleNode{
-					Value: model.Object{Value: "first"},
+				Tail: &DoubleNode[string]{
+					Value: "first",
 				},
 			},
 			values: []string{
 				"second",
 				"third",
 			},
+			want: []string{"first", "second", "third"},
 		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			l := &DoublyLinkedList{
+			l := &DoublyLinkedList[string]{
 				Current: tt.fields.Current,
 				Head:    tt.fields.Head,
 				Tail:    tt.fields.Tail,
 			}
 			l.AddNode(BuildDoubleNodes(tt.values)...)
-			i := 0
-			for l.HasNext() {
-				var previous model.Object
-				var next model.Object
-				if l.Current.Previous != nil {
-					previous = l.Current.Previous.Value
-					wantPrevious := tt.values[i-1]
-					if previous.Value != wantPrevious {
-						t.Errorf("AddNode() mismatched values: previous: %v, expected: %v", previous.Value, wantPrevious)
-					}
-				}
-				if l.Current.Next != nil {
-					next = l.Current.Next.Value
-					var wantNext interface{}
-					if i+1 > len(tt.values) {
-						wantNext = tt.values[i+1]
-					}
-					if next.Value == wantNext {
-						t.Errorf("AddNode() mismatched values: next: %v, expected: %v", next.Value, wantNext)
-					}
-				}
-				i++
+			// Walking both ways checks every Next and Previous link
+			var forward, backward []string
+			for it := l.Iterator(); it.Next(); {
+				forward = append(forward, it.Value())
+			}
+			for it := l.ReverseIterator(); it.Prev(); {
+				backward = append([]string{it.Value()}, backward...)
+			}
+			if !reflect.DeepEqual(forward, tt.want) {
+				t.Errorf("AddNode() list forwards = %v, want %v", forward, tt.want)
+			}
+			if !reflect.DeepEqual(backward, tt.want) {
+				t.Errorf("AddNode() list backwards = %v, want %v", backward, tt.want)
+			}
+		})
+	}
+}
+
+func TestDoublyLinkedList_AddNode(t *testing.T) {
+	// Lists built through the constructor, where a single Node is both Head and Tail
+	tests := []struct {
+		name     string
+		existing []string
+		values   []string
+		want     []string
+	}{
+		{name: "empty list", values: []string{"a", "b"}, want: []string{"a", "b"}},
+		{name: "empty list, no nodes", want: nil},
+		{name: "single-node list", existing: []string{"a"}, values: []string{"b"}, want: []string{"a", "b"}},
+		{name: "single-node list, several nodes", existing: []string{"a"}, values: []string{"b", "c"}, want: []string{"a", "b", "c"}},
+		{name: "single-node list, no nodes", existing: []string{"a"}, want: []string{"a"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			l := NewDoublyLinked(tt.existing...)
+			l.AddNode(BuildDoubleNodes(tt.values)...)
+			if got := readBothWays(t, l); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("AddNode() list = %v, want %v", got, tt.want)
 			}
 		})
 	}
@@ -270,12 +299,12 @@ func TestDoublyLinkedList_addNode(t *testing.T) {
 
 func TestDoublyLinkedList_Remove(t *testing.T) {
 	type fields struct {
-		Current *DoubleNode
-		Head    *DoubleNode
-		Tail    *DoubleNode
+		Current *DoubleNode[string]
+		Head    *DoubleNode[string]
+		Tail    *DoubleNode[string]
 	}
 	type args struct {
-		obj model.Object
+		obj string
 	}
 	tests := []struct {
 		name         string
@@ -288,7 +317,7 @@ func TestDoublyLinkedList_Remove(t *testing.T) {
 		{
 			name: "empty list returns error",
 			args: args{
-				obj: model.Object{Value: "second"},
+				obj: "second",
 			},
 			wantErr: true,
 		},
@@ -304,7 +333,7 @@ func TestDoublyLinkedList_Remove(t *testing.T) {
 				"third",
 			},
 			args: args{
-				obj: model.Object{Value: "second"},
+				obj: "second",
 			},
 		},
 		{
@@ -319,7 +348,7 @@ func TestDoublyLinkedList_Remove(t *testing.T) {
 				"third",
 			},
 			args: args{
-				obj: model.Object{Value: "first"},
+				obj: "first",
 			},
 		},
 		{
@@ -332,18 +361,18 @@ func TestDoublyLinkedList_Remove(t *testing.T) {
 				"first",
 			},
 			args: args{
-				obj: model.Object{Value: "second"},
+				obj: "second",
 			},
 		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			l := &DoublyLinkedList{
+			l := &DoublyLinkedList[string]{
 				Current: tt.fields.Current,
 				Head:    tt.fields.Head,
 				Tail:    tt.fields.Tail,
 			}
-			expected := &DoublyLinkedList{}
+			expected := &DoublyLinkedList[string]{}
 			l.AddNode(BuildDoubleNodes(tt.values)...)
 			expected.AddNode(BuildDoubleNodes(tt.expectedList)...)
 			if err := l.Remove(tt.args.obj); (err != nil) != tt.wantErr {
@@ -362,9 +391,9 @@ func TestDoublyLinkedList_Remove(t *testing.T) {
 
 func TestDoublyLinkedList_HasNext(t *testing.T) {
 	type fields struct {
-		Current *DoubleNode
-		Head    *DoubleNode
-		Tail    *DoubleNode
+		Current *DoubleNode[string]
+		Head    *DoubleNode[string]
+		Tail    *DoubleNode[string]
 	}
 	tests := []struct {
 		name   string
@@ -374,10 +403,10 @@ func TestDoublyLinkedList_HasNext(t *testing.T) {
 		{
 			name: "has next",
 			fields: fields{
-				Head: &DoubleNode{
-					Value: model.Object{Value: "first"},
-					Next: &DoubleNode{
-						Value: model.Object{Value: "second"},
+				Head: &DoubleNode[string]{
+					Value: "first",
+					Next: &DoubleNode[string]{
+						Value: "second",
 					},
 				},
 			},
@@ -386,8 +415,8 @@ func TestDoublyLinkedList_HasNext(t *testing.T) {
 		{
 			name: "no next",
 			fields: fields{
-				Head: &DoubleNode{
-					Value: model.Object{Value: "first"},
+				Head: &DoubleNode[string]{
+					Value: "first",
 				},
 			},
 			want: false,
@@ -395,7 +424,7 @@ func TestDoublyLinkedList_HasNext(t *testing.T) {
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			l := &DoublyLinkedList{
+			l := &DoublyLinkedList[string]{
 				Current: tt.fields.Current,
 				Head:    tt.fields.Head,
 				Tail:    tt.fields.Tail,
@@ -409,9 +438,9 @@ func TestDoublyLinkedList_HasNext(t *testing.T) {
 
 func TestDoublyLinkedList_HasPrevious(t *testing.T) {
 	type fields struct {
-		Current *DoubleNode
-		Head    *DoubleNode
-		Tail    *DoubleNode
+		Current *DoubleNode[string]
+		Head    *DoubleNode[string]
+		Tail    *DoubleNode[string]
 	}
 	tests := []struct {
 		name   string
@@ -421,16 +450,16 @@ func TestDoublyLinkedList_HasPrevious(t *testing.T) {
 		{
 			name: "has previous",
 			fields: fields{
-				Head: &DoubleNode{
-					Value: model.Object{Value: "first"},
-					Next: &DoubleNode{
-						Value: model.Object{Value: "second"},
+				Head: &DoubleNode[string]{
+					Value: "first",
+					Next: &DoubleNode[string]{
+						Value: "second",
 					},
 				},
-				Tail: &DoubleNode{
-					Value: model.Object{Value: "second"},
-					Previous: &DoubleNode{
-						Value: model.Object{Value: "first"},
+				Tail: &DoubleNode[string]{
+					Value: "second",
+					Previous: &DoubleNode[string]{
+						Value: "first",
 					},
 				},
 			},
@@ -439,8 +468,8 @@ func TestDoublyLinkedList_HasPrevious(t *testing.T) {
 		{
 			name: "no previous",
 			fields: fields{
-				Head: &DoubleNode{
-					Value: model.Object{Value: "first"},
+				Head: &DoubleNode[string]{
+					Value: "first",
 				},
 			},
 			want: false,
@@ -448,7 +477,7 @@ func TestDoublyLinkedList_HasPrevious(t *testing.T) {
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			l := &DoublyLinkedList{
+			l := &DoublyLinkedList[string]{
 				Current: tt.fields.Current,
 				Head:    tt.fields.Head,
 				Tail:    tt.fields.Tail,
@@ -459,3 +488,163 @@ func TestDoublyLinkedList_HasPrevious(t *testing.T) {
 		})
 	}
 }
+
+func TestNewDoublyLinkedFunc(t *testing.T) {
+	byID := func(a, b record) bool { return a.ID == b.ID }
+	tests := []struct {
+		name     string
+		values   []record
+		remove   record
+		expected []int
+		wantErr  bool
+	}{
+		{
+			name:    "empty list returns error",
+			remove:  record{ID: 1},
+			wantErr: true,
+		},
+		{
+			name: "non-comparable value is removed using the supplied EqualFunc",
+			values: []record{
+				{ID: 1, Tags: []string{"first"}},
+				{ID: 2, Tags: []string{"second"}},
+				{ID: 3, Tags: []string{"third"}},
+			},
+			remove:   record{ID: 2},
+			expected: []int{1, 3},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			l := NewDoublyLinkedFunc(byID, tt.values...)
+			if err := l.Remove(tt.remove); (err != nil) != tt.wantErr {
+				t.Errorf("DoublyLinkedList.Remove() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			var got []int
+			for n := l.Head; n != nil; n = n.Next {
+				got = append(got, n.Value.ID)
+			}
+			if !reflect.DeepEqual(got, tt.expected) {
+				t.Errorf("DoublyLinkedList.Remove() = %v, want %v", got, tt.expected)
+			}
+		})
+	}
+}
+
+func TestDoublyLinkedList_Len(t *testing.T) {
+	l := NewDoublyLinked("a", "b")
+	steps := []struct {
+		name string
+		op   func()
+		want int
+	}{
+		{name: "built from values", op: func() {}, want: 2},
+		{name: "AddHead", op: func() { l.AddHead("c") }, want: 3},
+		{name: "AddTail", op: func() { l.AddTail("d") }, want: 4},
+		{name: "AddNode", op: func() { l.AddNode(BuildDoubleNodes([]string{"e", "f"})...) }, want: 6},
+		{name: "Remove head", op: func() { l.Remove("c") }, want: 5},
+		{name: "Remove tail", op: func() { l.Remove("f") }, want: 4},
+		{name: "Remove missing value", op: func() { l.Remove("z") }, want: 4},
+		{name: "InsertAt", op: func() { l.InsertAt(2, "g") }, want: 5},
+		{name: "RemoveAt", op: func() { l.RemoveAt(1) }, want: 4},
+		{name: "RemoveHead", op: func() { l.RemoveHead() }, want: 3},
+		{name: "RemoveTail", op: func() { l.RemoveTail() }, want: 2},
+	}
+	for _, s := range steps {
+		s.op()
+		walked := 0
+		for it := l.Iterator(); it.Next(); {
+			walked++
+		}
+		if got := l.Len(); got != s.want || got != walked {
+			t.Errorf("after %s Len() = %d, want %d (walked %d)", s.name, got, s.want, walked)
+		}
+	}
+	if l.IsEmpty() {
+		t.Errorf("IsEmpty() = true with %d values", l.Len())
+	}
+	for l.Head != nil {
+		l.Remove(l.Head.Value)
+	}
+	if l.Len() != 0 || !l.IsEmpty() {
+		t.Errorf("emptied list Len() = %d, IsEmpty() = %v", l.Len(), l.IsEmpty())
+	}
+}
+
+func TestDoublyLinkedList_Len_SingleNode(t *testing.T) {
+	l := NewDoublyLinked("a")
+	if l.Len() != 1 || l.IsEmpty() {
+		t.Errorf("Len() = %d, IsEmpty() = %v, want 1, false", l.Len(), l.IsEmpty())
+	}
+	l.AddNode(BuildDoubleNodes([]string{"b", "c"})...)
+	if got := readBothWays(t, l); len(got) != 3 {
+		t.Errorf("AddNode() onto a single-node list holds %v", got)
+	}
+	for l.Head != nil {
+		l.Remove(l.Head.Value)
+	}
+	if l.Len() != 0 || !l.IsEmpty() {
+		t.Errorf("emptied list Len() = %d, IsEmpty() = %v", l.Len(), l.IsEmpty())
+	}
+}
+
+func TestDoublyLinkedList_Len_HandBuilt(t *testing.T) {
+	// Nodes linked onto Head by hand aren't counted, but removing them mustn't take Len below 0
+	a := &DoubleNode[string]{Value: "a"}
+	b := &DoubleNode[string]{Value: "b", Previous: a}
+	a.Next = b
+	l := &DoublyLinkedList[string]{Head: a, Tail: b}
+	if l.IsEmpty() {
+		t.Errorf("IsEmpty() = true with a Head")
+	}
+	l.AddHead("c")
+	for _, remove := range []func(){
+		func() { l.RemoveHead() },
+		func() { l.Remove("a") },
+		func() { l.RemoveTail() },
+	} {
+		remove()
+		if l.Len() < 0 {
+			t.Errorf("Len() = %d", l.Len())
+		}
+	}
+	if l.Len() != 0 || !l.IsEmpty() {
+		t.Errorf("emptied list Len() = %d, IsEmpty() = %v", l.Len(), l.IsEmpty())
+	}
+}
+
+func TestDoublyLinkedList_RemoveHeadTail(t *testing.T) {
+	tests := []struct {
+		name    string
+		values  []string
+		tail    bool
+		want    string
+		wantErr error
+		rest    []string
+	}{
+		{name: "head of empty list", wantErr: ErrEmpty},
+		{name: "tail of empty list", tail: true, wantErr: ErrEmpty},
+		{name: "head of one value", values: []string{"a"}, want: "a"},
+		{name: "tail of one value", values: []string{"a"}, tail: true, want: "a"},
+		{name: "head", values: []string{"a", "b", "c"}, want: "a", rest: []string{"b", "c"}},
+		{name: "tail", values: []string{"a", "b", "c"}, tail: true, want: "c", rest: []string{"a", "b"}},
+		{name: "head with duplicates", values: []string{"a", "b", "a"}, want: "a", rest: []string{"b", "a"}},
+		{name: "tail with duplicates", values: []string{"a", "b", "a"}, tail: true, want: "a", rest: []string{"a", "b"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			l := NewDoublyLinked(tt.values...)
+			remove := l.RemoveHead
+			if tt.tail {
+				remove = l.RemoveTail
+			}
+			got, err := remove()
+			if got != tt.want || err != tt.wantErr {
+				t.Errorf("got %q, %v, want %q, %v", got, err, tt.want, tt.wantErr)
+			}
+			if rest := readBothWays(t, l); !slices.Equal(rest, tt.rest) {
+				t.Errorf("list holds %v, want %v", rest, tt.rest)
+			}
+		})
+	}
+}
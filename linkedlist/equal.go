@@ -0,0 +1,26 @@
+package linkedlist
+
+// EqualFunc :: func :: Reports whether two list values should be treated as the same value.
+// Used by Find, FindNode and Remove in place of a hard-wired comparison.
+type EqualFunc[T any] func(a, b T) bool
+
+// Equal :: func :: Reports whether a and b are equal by eq, or by == when eq is nil as it is
+// for zero-value lists. Lets code holding an EqualFunc compare values the way the lists do.
+func (eq EqualFunc[T]) Equal(a, b T) bool {
+	return equals(eq, a, b)
+}
+
+// equals :: func :: Falls back to == when no EqualFunc was supplied, which is the case for
+// zero-value lists. Comparing values of a non-comparable T this way will panic, so those
+// lists should be built with NewSinglyLinkedFunc / NewDoublyLinkedFunc.
+func equals[T any](eq EqualFunc[T], a, b T) bool {
+	if eq != nil {
+		return eq(a, b)
+	}
+	return any(a) == any(b)
+}
+
+// comparableEqual :: func :: EqualFunc for comparable types
+func comparableEqual[T comparable](a, b T) bool {
+	return a == b
+}
@@ -0,0 +1,23 @@
+package linkedlist
+
+import (
+	"errors"
+	"fmt"
+)
+
+// ErrNotFound :: var :: Returned by Remove when no value in the list is equal to the one asked for
+var ErrNotFound = errors.New("object not found in list")
+
+// ErrEmpty :: var :: Returned by RemoveHead and RemoveTail when the list holds no values
+var ErrEmpty = errors.New("list is empty")
+
+// IndexError :: struct :: Returned by the index-based operations when an index falls outside the list.
+// Check for it with errors.As.
+type IndexError struct {
+	Index int
+	Len   int
+}
+
+func (e *IndexError) Error() string {
+	return fmt.Sprintf("index %d out of range for list of length %d", e.Index, e.Len)
+}
@@ -0,0 +1,105 @@
+package linkedlist
+
+import (
+	"errors"
+)
+
+// Node-handle operations on DoublyLinkedList. These take a *DoubleNode the caller already
+// holds, as returned by AddHead, AddTail, FindNode or one of the Insert methods, and so
+// run in O(1) rather than searching the list for a value. A Node that isn't in the list
+// (one that was never added, belongs to another list, or has been removed) is refused with ErrNotInList.
+
+// ErrNotInList :: var :: Returned by the node-handle methods when the Node passed in isn't in the list
+var ErrNotInList = errors.New("node is not in this list")
+
+// Unlink :: func :: Removes node from the list
+func (l *DoublyLinkedList[T]) Unlink(node *DoubleNode[T]) error {
+	if !l.owns(node) {
+		return ErrNotInList
+	}
+	l.unlink(node)
+	return nil
+}
+
+// InsertBefore :: func :: Adds obj to the list directly in front of mark, returning its new Node
+func (l *DoublyLinkedList[T]) InsertBefore(mark *DoubleNode[T], obj T) (*DoubleNode[T], error) {
+	if !l.owns(mark) {
+		return nil, ErrNotInList
+	}
+	node := &DoubleNode[T]{Value: obj}
+	l.link(node, mark.Previous, mark)
+	return node, nil
+}
+
+// InsertAfter :: func :: Adds obj to the list directly behind mark, returning its new Node
+func (l *DoublyLinkedList[T]) InsertAfter(mark *DoubleNode[T], obj T) (*DoubleNode[T], error) {
+	if !l.owns(mark) {
+		return nil, ErrNotInList
+	}
+	node := &DoubleNode[T]{Value: obj}
+	l.link(node, mark, mark.Next)
+	return node, nil
+}
+
+// MoveToFront :: func :: Moves node to the Head of the list
+func (l *DoublyLinkedList[T]) MoveToFront(node *DoubleNode[T]) error {
+	if !l.owns(node) {
+		return ErrNotInList
+	}
+	if node != l.Head {
+		l.unlink(node)
+		l.link(node, nil, l.Head)
+	}
+	return nil
+}
+
+// MoveToBack :: func :: Moves node to the Tail of the list
+func (l *DoublyLinkedList[T]) MoveToBack(node *DoubleNode[T]) error {
+	if !l.owns(node) {
+		return ErrNotInList
+	}
+	if node != l.Tail {
+		l.unlink(node)
+		l.link(node, l.Tail, nil)
+	}
+	return nil
+}
+
+// owns :: func :: reports whether node is currently in this list
+func (l *DoublyLinkedList[T]) owns(node *DoubleNode[T]) bool {
+	return node != nil && node.list == l
+}
+
+// link :: func :: puts node into the list between previous and next,
+// where a nil previous or next means node becomes the Head or Tail
+func (l *DoublyLinkedList[T]) link(node, previous, next *DoubleNode[T]) {
+	node.Previous, node.Next, node.list = previous, next, l
+	if previous != nil {
+		previous.Next = node
+	} else {
+		l.Head = node
+	}
+	if next != nil {
+		next.Previous = node
+	} else {
+		l.Tail = node
+	}
+	l.size++
+}
+
+// unlink :: func :: takes node out of the list, joining up its neighbours around it.
+// node keeps its own Next and Previous, so an Iterator sitting on it can still move off it.
+func (l *DoublyLinkedList[T]) unlink(node *DoubleNode[T]) {
+	if node.Previous != nil {
+		node.Previous.Next = node.Next
+	} else {
+		l.Head = node.Next
+	}
+	if node.Next != nil {
+		node.Next.Previous = node.Previous
+	} else {
+		l.Tail = node.Previous
+	}
+	node.list = nil
+	l.shrink()
+}
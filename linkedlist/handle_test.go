@@ -0,0 +1,295 @@
+package linkedlist
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+)
+
+// readBothWays :: func :: returns the list's values, failing the test if walking it from either
+// end disagrees or Len is off
+func readBothWays(t *testing.T, l *DoublyLinkedList[string]) []string {
+	t.Helper()
+	var forward, backward []string
+	for it := l.Iterator(); it.Next(); {
+		forward = append(forward, it.Value())
+	}
+	for it := l.ReverseIterator(); it.Prev(); {
+		backward = append([]string{it.Value()}, backward...)
+	}
+	if !reflect.DeepEqual(forward, backward) {
+		t.Errorf("list reads %v forwards but %v backwards", forward, backward)
+	}
+	if l.Len() != len(forward) {
+		t.Errorf("Len() = %d, but the list holds %d values", l.Len(), len(forward))
+	}
+	return forward
+}
+
+// handles :: func :: builds a list of values, returning it along with the Node holding each value
+func handles(values ...string) (*DoublyLinkedList[string], map[string]*DoubleNode[string]) {
+	l := NewDoublyLinked[string]()
+	nodes := map[string]*DoubleNode[string]{}
+	for _, v := range values {
+		nodes[v] = l.AddTail(v)
+	}
+	return l, nodes
+}
+
+func TestDoublyLinkedList_NodeHandles(t *testing.T) {
+	tests := []struct {
+		name string
+		op   func(l *DoublyLinkedList[string], nodes map[string]*DoubleNode[string]) error
+		want []string
+	}{
+		{
+			name: "unlink head",
+			op: func(l *DoublyLinkedList[string], n map[string]*DoubleNode[string]) error {
+				return l.Unlink(n["a"])
+			},
+			want: []string{"b", "c"},
+		},
+		{
+			name: "unlink middle",
+			op: func(l *DoublyLinkedList[string], n map[string]*DoubleNode[string]) error {
+				return l.Unlink(n["b"])
+			},
+			want: []string{"a", "c"},
+		},
+		{
+			name: "unlink tail",
+			op: func(l *DoublyLinkedList[string], n map[string]*DoubleNode[string]) error {
+				return l.Unlink(n["c"])
+			},
+			want: []string{"a", "b"},
+		},
+		{
+			name: "unlink everything",
+			op: func(l *DoublyLinkedList[string], n map[string]*DoubleNode[string]) error {
+				return errors.Join(l.Unlink(n["b"]), l.Unlink(n["a"]), l.Unlink(n["c"]))
+			},
+		},
+		{
+			name: "insert before head",
+			op: func(l *DoublyLinkedList[string], n map[string]*DoubleNode[string]) error {
+				_, err := l.InsertBefore(n["a"], "x")
+				return err
+			},
+			want: []string{"x", "a", "b", "c"},
+		},
+		{
+			name: "insert before middle",
+			op: func(l *DoublyLinkedList[string], n map[string]*DoubleNode[string]) error {
+				_, err := l.InsertBefore(n["b"], "x")
+				return err
+			},
+			want: []string{"a", "x", "b", "c"},
+		},
+		{
+			name: "insert after middle",
+			op: func(l *DoublyLinkedList[string], n map[string]*DoubleNode[string]) error {
+				_, err := l.InsertAfter(n["b"], "x")
+				return err
+			},
+			want: []string{"a", "b", "x", "c"},
+		},
+		{
+			name: "insert after tail",
+			op: func(l *DoublyLinkedList[string], n map[string]*DoubleNode[string]) error {
+				_, err := l.InsertAfter(n["c"], "x")
+				return err
+			},
+			want: []string{"a", "b", "c", "x"},
+		},
+		{
+			name: "inserted nodes are handles too",
+			op: func(l *DoublyLinkedList[string], n map[string]*DoubleNode[string]) error {
+				x, err := l.InsertAfter(n["a"], "x")
+				if err != nil {
+					return err
+				}
+				return l.MoveToBack(x)
+			},
+			want: []string{"a", "b", "c", "x"},
+		},
+		{
+			name: "move tail to front",
+			op: func(l *DoublyLinkedList[string], n map[string]*DoubleNode[string]) error {
+				return l.MoveToFront(n["c"])
+			},
+			want: []string{"c", "a", "b"},
+		},
+		{
+			name: "move middle to front",
+			op: func(l *DoublyLinkedList[string], n map[string]*DoubleNode[string]) error {
+				return l.MoveToFront(n["b"])
+			},
+			want: []string{"b", "a", "c"},
+		},
+		{
+			name: "move head to front",
+			op: func(l *DoublyLinkedList[string], n map[string]*DoubleNode[string]) error {
+				return l.MoveToFront(n["a"])
+			},
+			want: []string{"a", "b", "c"},
+		},
+		{
+			name: "move head to back",
+			op: func(l *DoublyLinkedList[string], n map[string]*DoubleNode[string]) error {
+				return l.MoveToBack(n["a"])
+			},
+			want: []string{"b", "c", "a"},
+		},
+		{
+			name: "move tail to back",
+			op: func(l *DoublyLinkedList[string], n map[string]*DoubleNode[string]) error {
+				return l.MoveToBack(n["c"])
+			},
+			want: []string{"a", "b", "c"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			l, nodes := handles("a", "b", "c")
+			if err := tt.op(l, nodes); err != nil {
+				t.Fatalf("error = %v", err)
+			}
+			if got := readBothWays(t, l); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("list = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDoublyLinkedList_NodeHandles_SingleNode(t *testing.T) {
+	// A list built from one value, where Head and Tail are the same Node, then grown with AddNode
+	tests := []struct {
+		name string
+		op   func(l *DoublyLinkedList[string], a, b *DoubleNode[string]) error
+		want []string
+	}{
+		{
+			name: "unlink the original node",
+			op: func(l *DoublyLinkedList[string], a, b *DoubleNode[string]) error {
+				return l.Unlink(a)
+			},
+			want: []string{"b"},
+		},
+		{
+			name: "move the original node to the back",
+			op: func(l *DoublyLinkedList[string], a, b *DoubleNode[string]) error {
+				return l.MoveToBack(a)
+			},
+			want: []string{"b", "a"},
+		},
+		{
+			name: "move an added node to the front",
+			op: func(l *DoublyLinkedList[string], a, b *DoubleNode[string]) error {
+				return l.MoveToFront(b)
+			},
+			want: []string{"b", "a"},
+		},
+		{
+			name: "insert between them",
+			op: func(l *DoublyLinkedList[string], a, b *DoubleNode[string]) error {
+				_, err := l.InsertAfter(a, "x")
+				return err
+			},
+			want: []string{"a", "x", "b"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			l := NewDoublyLinked("a")
+			a := l.Head
+			b := &DoubleNode[string]{Value: "b"}
+			l.AddNode(b)
+			if err := tt.op(l, a, b); err != nil {
+				t.Fatalf("error = %v", err)
+			}
+			if got := readBothWays(t, l); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("list = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDoublyLinkedList_ForeignNodes(t *testing.T) {
+	l, nodes := handles("a", "b")
+	other, otherNodes := handles("x")
+	removed := nodes["b"]
+	if err := l.Unlink(removed); err != nil {
+		t.Fatalf("Unlink() error = %v", err)
+	}
+	foreign := map[string]*DoubleNode[string]{
+		"nil":                 nil,
+		"built by hand":       {Value: "a"},
+		"from another list":   otherNodes["x"],
+		"already unlinked":    removed,
+		"removed by value":    nil,
+		"removed by RemoveAt": nil,
+	}
+	l.AddTail("c")
+	foreign["removed by value"], _ = l.FindNode("c")
+	l.Remove("c")
+	l.AddTail("d")
+	foreign["removed by RemoveAt"], _ = l.FindNode("d")
+	l.RemoveAt(1)
+
+	for name, node := range foreign {
+		t.Run(name, func(t *testing.T) {
+			errs := map[string]error{
+				"Unlink":      l.Unlink(node),
+				"MoveToFront": l.MoveToFront(node),
+				"MoveToBack":  l.MoveToBack(node),
+			}
+			_, errs["InsertBefore"] = l.InsertBefore(node, "y")
+			_, errs["InsertAfter"] = l.InsertAfter(node, "y")
+			for op, err := range errs {
+				if !errors.Is(err, ErrNotInList) {
+					t.Errorf("%s() error = %v, want ErrNotInList", op, err)
+				}
+			}
+			if got := readBothWays(t, l); !reflect.DeepEqual(got, []string{"a"}) {
+				t.Errorf("list = %v after refused operations, want [a]", got)
+			}
+		})
+	}
+	if got := readBothWays(t, other); !reflect.DeepEqual(got, []string{"x"}) {
+		t.Errorf("other list = %v, want [x]", got)
+	}
+}
+
+func TestDoublyLinkedList_AddNodeMoves(t *testing.T) {
+	tests := []struct {
+		name      string
+		node      string
+		want      []string
+		wantOther []string
+	}{
+		{name: "from another list", node: "x", want: []string{"a", "b", "c", "x"}, wantOther: []string{"y"}},
+		{name: "head of this list", node: "a", want: []string{"b", "c", "a"}, wantOther: []string{"x", "y"}},
+		{name: "middle of this list", node: "b", want: []string{"a", "c", "b"}, wantOther: []string{"x", "y"}},
+		{name: "tail of this list", node: "c", want: []string{"a", "b", "c"}, wantOther: []string{"x", "y"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			l, nodes := handles("a", "b", "c")
+			other, otherNodes := handles("x", "y")
+			for v, node := range otherNodes {
+				nodes[v] = node
+			}
+			l.AddNode(nodes[tt.node])
+			if got := readBothWays(t, l); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("list = %v after AddNode(%s), want %v", got, tt.node, tt.want)
+			}
+			if got := readBothWays(t, other); !reflect.DeepEqual(got, tt.wantOther) {
+				t.Errorf("other list = %v after AddNode(%s), want %v", got, tt.node, tt.wantOther)
+			}
+			// The moved Node is a handle on the list it was added to
+			if err := l.MoveToFront(nodes[tt.node]); err != nil {
+				t.Errorf("MoveToFront() error = %v after AddNode(%s)", err, tt.node)
+			}
+		})
+	}
+}
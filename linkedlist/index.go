@@ -0,0 +1,163 @@
+package linkedlist
+
+// Index-based operations. Indexes count from 0 at the Head; one outside the list
+// gets an *IndexError back rather than a panic. These rely on the list's own count of
+// its Nodes, so they only see Nodes added through the list's methods.
+
+// Get :: func :: Returns the value at index i
+func (l *SinglyLinkedList[T]) Get(i int) (T, error) {
+	node, err := l.nodeAt(i)
+	if err != nil {
+		var zero T
+		return zero, err
+	}
+	return node.Value, nil
+}
+
+// Set :: func :: Replaces the value at index i
+func (l *SinglyLinkedList[T]) Set(i int, obj T) error {
+	node, err := l.nodeAt(i)
+	if err != nil {
+		return err
+	}
+	node.Value = obj
+	return nil
+}
+
+// InsertAt :: func :: Inserts obj so that it ends up at index i, moving the value
+// already there (and everything after it) along by one. i may be the length of the list, to append.
+func (l *SinglyLinkedList[T]) InsertAt(i int, obj T) error {
+	if i < 0 || i > l.size {
+		return &IndexError{Index: i, Len: l.size}
+	}
+	if i == 0 {
+		l.Add(obj)
+		return nil
+	}
+	previous, _ := l.nodeAt(i - 1)
+	previous.Next = &Node[T]{Value: obj, Next: previous.Next}
+	l.size++
+	return nil
+}
+
+// RemoveAt :: func :: Removes the value at index i, returning it
+func (l *SinglyLinkedList[T]) RemoveAt(i int) (T, error) {
+	if i < 0 || i >= l.size {
+		var zero T
+		return zero, &IndexError{Index: i, Len: l.size}
+	}
+	var node *Node[T]
+	if i == 0 {
+		node = l.Head
+		l.Head = node.Next
+	} else {
+		previous, _ := l.nodeAt(i - 1)
+		node = previous.Next
+		previous.Next = node.Next
+	}
+	l.shrink()
+	return node.Value, nil
+}
+
+// IndexOf :: func :: Returns the index of the first value equal to obj, and whether there was one
+func (l *SinglyLinkedList[T]) IndexOf(obj T) (int, bool) {
+	i := 0
+	for node := l.Head; node != nil; node = node.Next {
+		if equals(l.equal, node.Value, obj) {
+			return i, true
+		}
+		i++
+	}
+	return -1, false
+}
+
+// nodeAt :: func :: walks from the Head to the Node at index i
+func (l *SinglyLinkedList[T]) nodeAt(i int) (*Node[T], error) {
+	if i < 0 || i >= l.size {
+		return nil, &IndexError{Index: i, Len: l.size}
+	}
+	node := l.Head
+	for ; i > 0; i-- {
+		node = node.Next
+	}
+	return node, nil
+}
+
+// Get :: func :: Returns the value at index i
+func (l *DoublyLinkedList[T]) Get(i int) (T, error) {
+	node, err := l.nodeAt(i)
+	if err != nil {
+		var zero T
+		return zero, err
+	}
+	return node.Value, nil
+}
+
+// Set :: func :: Replaces the value at index i
+func (l *DoublyLinkedList[T]) Set(i int, obj T) error {
+	node, err := l.nodeAt(i)
+	if err != nil {
+		return err
+	}
+	node.Value = obj
+	return nil
+}
+
+// InsertAt :: func :: Inserts obj so that it ends up at index i, moving the value
+// already there (and everything after it) along by one. i may be the length of the list, to append.
+func (l *DoublyLinkedList[T]) InsertAt(i int, obj T) error {
+	switch {
+	case i < 0 || i > l.size:
+		return &IndexError{Index: i, Len: l.size}
+	case i == 0:
+		l.AddHead(obj)
+	case i == l.size:
+		l.AddTail(obj)
+	default:
+		next, _ := l.nodeAt(i)
+		l.link(&DoubleNode[T]{Value: obj}, next.Previous, next)
+	}
+	return nil
+}
+
+// RemoveAt :: func :: Removes the value at index i, returning it
+func (l *DoublyLinkedList[T]) RemoveAt(i int) (T, error) {
+	node, err := l.nodeAt(i)
+	if err != nil {
+		var zero T
+		return zero, err
+	}
+	l.unlink(node)
+	return node.Value, nil
+}
+
+// IndexOf :: func :: Returns the index of the first value equal to obj, and whether there was one
+func (l *DoublyLinkedList[T]) IndexOf(obj T) (int, bool) {
+	i := 0
+	for node := l.Head; node != nil; node = node.Next {
+		if equals(l.equal, node.Value, obj) {
+			return i, true
+		}
+		i++
+	}
+	return -1, false
+}
+
+// nodeAt :: func :: walks to the Node at index i from whichever end of the list is closer
+func (l *DoublyLinkedList[T]) nodeAt(i int) (*DoubleNode[T], error) {
+	if i < 0 || i >= l.size {
+		return nil, &IndexError{Index: i, Len: l.size}
+	}
+	if i < l.size/2 {
+		node := l.Head
+		for ; i > 0; i-- {
+			node = node.Next
+		}
+		return node, nil
+	}
+	node := l.Tail
+	for i = l.size - 1 - i; i > 0; i-- {
+		node = node.Previous
+	}
+	return node, nil
+}
@@ -0,0 +1,208 @@
+package linkedlist
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+)
+
+// indexed :: interface :: the index-based API both lists share, so each test runs against both
+type indexed interface {
+	Get(i int) (string, error)
+	Set(i int, obj string) error
+	InsertAt(i int, obj string) error
+	RemoveAt(i int) (string, error)
+	IndexOf(obj string) (int, bool)
+}
+
+// lists :: var :: builds each kind of list, along with a function reading its values back.
+// The doubly linked one reads back from the Tail as well, to check the Previous links.
+var lists = map[string]func(t *testing.T, values ...string) (indexed, func() []string){
+	"singly": func(t *testing.T, values ...string) (indexed, func() []string) {
+		l := NewSinglyLinked(values...)
+		return l, func() []string {
+			var out []string
+			for it := l.Iterator(); it.Next(); {
+				out = append(out, it.Value())
+			}
+			return out
+		}
+	},
+	"doubly": func(t *testing.T, values ...string) (indexed, func() []string) {
+		l := NewDoublyLinked(values...)
+		return l, func() []string {
+			var out, backward []string
+			for it := l.Iterator(); it.Next(); {
+				out = append(out, it.Value())
+			}
+			for it := l.ReverseIterator(); it.Prev(); {
+				backward = append([]string{it.Value()}, backward...)
+			}
+			if !reflect.DeepEqual(out, backward) {
+				t.Errorf("list reads %v forwards but %v backwards", out, backward)
+			}
+			return out
+		}
+	},
+}
+
+func wantIndexError(t *testing.T, err error, index, length int) {
+	t.Helper()
+	var indexErr *IndexError
+	if !errors.As(err, &indexErr) {
+		t.Fatalf("error = %v, want an *IndexError", err)
+	}
+	if indexErr.Index != index || indexErr.Len != length {
+		t.Errorf("IndexError = %+v, want index %d, len %d", *indexErr, index, length)
+	}
+	if errors.Is(err, ErrNotFound) {
+		t.Errorf("IndexError is also ErrNotFound")
+	}
+}
+
+func TestLinkedList_Get(t *testing.T) {
+	values := []string{"a", "b", "c", "d", "e"}
+	for name, build := range lists {
+		t.Run(name, func(t *testing.T) {
+			l, _ := build(t, values...)
+			// Both halves, so the doubly linked list walks from each end
+			for i, want := range values {
+				if got, err := l.Get(i); got != want || err != nil {
+					t.Errorf("Get(%d) = %v, %v, want %v", i, got, err, want)
+				}
+			}
+			for _, i := range []int{-1, len(values)} {
+				_, err := l.Get(i)
+				wantIndexError(t, err, i, len(values))
+			}
+		})
+	}
+}
+
+func TestLinkedList_Set(t *testing.T) {
+	for name, build := range lists {
+		t.Run(name, func(t *testing.T) {
+			l, read := build(t, "a", "b", "c")
+			for i, v := range []string{"x", "y", "z"} {
+				if err := l.Set(i, v); err != nil {
+					t.Errorf("Set(%d) error = %v", i, err)
+				}
+			}
+			if got, want := read(), []string{"x", "y", "z"}; !reflect.DeepEqual(got, want) {
+				t.Errorf("list after Set() = %v, want %v", got, want)
+			}
+			wantIndexError(t, l.Set(3, "w"), 3, 3)
+		})
+	}
+}
+
+func TestLinkedList_InsertAt(t *testing.T) {
+	tests := []struct {
+		name    string
+		values  []string
+		index   int
+		want    []string
+		wantErr bool
+	}{
+		{name: "empty list", index: 0, want: []string{"new"}},
+		{name: "at the head", values: []string{"a", "b"}, index: 0, want: []string{"new", "a", "b"}},
+		{name: "in the middle", values: []string{"a", "b", "c", "d"}, index: 1, want: []string{"a", "new", "b", "c", "d"}},
+		{name: "in the back half", values: []string{"a", "b", "c", "d"}, index: 3, want: []string{"a", "b", "c", "new", "d"}},
+		{name: "at the end", values: []string{"a", "b"}, index: 2, want: []string{"a", "b", "new"}},
+		{name: "past the end", values: []string{"a", "b"}, index: 3, want: []string{"a", "b"}, wantErr: true},
+		{name: "negative", values: []string{"a", "b"}, index: -1, want: []string{"a", "b"}, wantErr: true},
+	}
+	for name, build := range lists {
+		for _, tt := range tests {
+			t.Run(name+"/"+tt.name, func(t *testing.T) {
+				l, read := build(t, tt.values...)
+				err := l.InsertAt(tt.index, "new")
+				if tt.wantErr {
+					wantIndexError(t, err, tt.index, len(tt.values))
+				} else if err != nil {
+					t.Errorf("InsertAt() error = %v", err)
+				}
+				if got := read(); !reflect.DeepEqual(got, tt.want) {
+					t.Errorf("list after InsertAt() = %v, want %v", got, tt.want)
+				}
+				// The inserted value should be reachable by index too
+				if !tt.wantErr {
+					if got, _ := l.Get(tt.index); got != "new" {
+						t.Errorf("Get(%d) after InsertAt() = %v, want new", tt.index, got)
+					}
+				}
+			})
+		}
+	}
+}
+
+func TestLinkedList_RemoveAt(t *testing.T) {
+	tests := []struct {
+		name    string
+		values  []string
+		index   int
+		want    string
+		left    []string
+		wantErr bool
+	}{
+		{name: "only value", values: []string{"a"}, index: 0, want: "a"},
+		{name: "head", values: []string{"a", "b", "c"}, index: 0, want: "a", left: []string{"b", "c"}},
+		{name: "middle", values: []string{"a", "b", "c"}, index: 1, want: "b", left: []string{"a", "c"}},
+		{name: "tail", values: []string{"a", "b", "c"}, index: 2, want: "c", left: []string{"a", "b"}},
+		{name: "empty list", index: 0, wantErr: true},
+		{name: "past the end", values: []string{"a"}, index: 1, left: []string{"a"}, wantErr: true},
+	}
+	for name, build := range lists {
+		for _, tt := range tests {
+			t.Run(name+"/"+tt.name, func(t *testing.T) {
+				l, read := build(t, tt.values...)
+				got, err := l.RemoveAt(tt.index)
+				if tt.wantErr {
+					wantIndexError(t, err, tt.index, len(tt.values))
+				} else if err != nil {
+					t.Errorf("RemoveAt() error = %v", err)
+				}
+				if got != tt.want {
+					t.Errorf("RemoveAt() = %v, want %v", got, tt.want)
+				}
+				if got := read(); !reflect.DeepEqual(got, tt.left) {
+					t.Errorf("list after RemoveAt() = %v, want %v", got, tt.left)
+				}
+			})
+		}
+	}
+}
+
+func TestLinkedList_IndexOf(t *testing.T) {
+	tests := []struct {
+		name      string
+		obj       string
+		want      int
+		wantFound bool
+	}{
+		{name: "head", obj: "a", want: 0, wantFound: true},
+		{name: "first of duplicates", obj: "b", want: 1, wantFound: true},
+		{name: "tail", obj: "c", want: 3, wantFound: true},
+		{name: "missing", obj: "z", want: -1},
+	}
+	for name, build := range lists {
+		l, _ := build(t, "a", "b", "b", "c")
+		for _, tt := range tests {
+			t.Run(name+"/"+tt.name, func(t *testing.T) {
+				if got, found := l.IndexOf(tt.obj); got != tt.want || found != tt.wantFound {
+					t.Errorf("IndexOf(%v) = %v, %v, want %v, %v", tt.obj, got, found, tt.want, tt.wantFound)
+				}
+			})
+		}
+	}
+}
+
+func TestLinkedList_RemoveNotFound(t *testing.T) {
+	single := NewSinglyLinked("a")
+	double := NewDoublyLinked("a")
+	for name, err := range map[string]error{"singly": single.Remove("z"), "doubly": double.Remove("z")} {
+		if !errors.Is(err, ErrNotFound) {
+			t.Errorf("%s Remove() of a missing value = %v, want ErrNotFound", name, err)
+		}
+	}
+}
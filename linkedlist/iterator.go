@@ -0,0 +1,117 @@
+package linkedlist
+
+// Iterators walk a list without touching it, so any number of them can be walking the
+// same list at once and Find, Remove or AddNode calls in between don't move them.
+// A new Iterator sits before the Head; each call to Next moves it along one Node and
+// reports whether it landed on one:
+//
+//	it := l.Iterator()
+//	for it.Next() {
+//		fmt.Println(it.Value())
+//	}
+//
+// Removing the Node an Iterator is on unlinks it from the list, but the Iterator can
+// still step off it to whatever followed it at the time.
+
+// Iterator :: struct :: Cursor over a SinglyLinkedList
+type Iterator[T any] struct {
+	list *SinglyLinkedList[T]
+	node *Node[T]
+	done bool
+}
+
+// Iterator :: func :: Returns a new Iterator positioned before the Head of the list
+func (l *SinglyLinkedList[T]) Iterator() *Iterator[T] {
+	return &Iterator[T]{list: l}
+}
+
+// Next :: func :: Advances to the next Node, returning false once it's gone past the end of the list
+func (it *Iterator[T]) Next() bool {
+	switch {
+	case it.node != nil:
+		it.node = it.node.Next
+	case !it.done:
+		it.node = it.list.Head
+	}
+	it.done = it.node == nil
+	return !it.done
+}
+
+// Value :: func :: Returns the value of the Node the Iterator is on,
+// or the zero value if it's before the Head or past the end
+func (it *Iterator[T]) Value() T {
+	if it.node == nil {
+		var zero T
+		return zero
+	}
+	return it.node.Value
+}
+
+// Reset :: func :: Moves the Iterator back to before the Head of the list
+func (it *Iterator[T]) Reset() {
+	it.node = nil
+	it.done = false
+}
+
+// DoubleIterator :: struct :: Cursor over a DoublyLinkedList, which can move in either direction.
+// Off the list it's either before the Head or after the Tail: Next from after the Tail and Prev
+// from before the Head return false, while Prev from after the Tail steps back onto the Tail.
+type DoubleIterator[T any] struct {
+	list  *DoublyLinkedList[T]
+	node  *DoubleNode[T]
+	after bool
+	// fromTail records where the Iterator started, for Reset
+	fromTail bool
+}
+
+// Iterator :: func :: Returns a new DoubleIterator positioned before the Head of the list
+func (l *DoublyLinkedList[T]) Iterator() *DoubleIterator[T] {
+	return &DoubleIterator[T]{list: l}
+}
+
+// ReverseIterator :: func :: Returns a new DoubleIterator positioned after the Tail of the list,
+// for walking it backwards with Prev
+func (l *DoublyLinkedList[T]) ReverseIterator() *DoubleIterator[T] {
+	return &DoubleIterator[T]{list: l, after: true, fromTail: true}
+}
+
+// Next :: func :: Advances to the next Node, returning false once it's gone past the Tail
+func (it *DoubleIterator[T]) Next() bool {
+	switch {
+	case it.node != nil:
+		it.node = it.node.Next
+	case !it.after:
+		it.node = it.list.Head
+	}
+	it.after = it.node == nil
+	return !it.after
+}
+
+// Prev :: func :: Steps back to the previous Node, returning false once it's gone before the Head
+func (it *DoubleIterator[T]) Prev() bool {
+	switch {
+	case it.node != nil:
+		it.node = it.node.Previous
+	case it.after:
+		it.node = it.list.Tail
+	}
+	it.after = false
+	return it.node != nil
+}
+
+// Value :: func :: Returns the value of the Node the Iterator is on,
+// or the zero value if it's off either end of the list
+func (it *DoubleIterator[T]) Value() T {
+	if it.node == nil {
+		var zero T
+		return zero
+	}
+	return it.node.Value
+}
+
+// Reset :: func :: Moves the Iterator back to where it started: before the Head,
+// or after the Tail for one made by ReverseIterator
+func (it *DoubleIterator[T]) Reset() {
+	it.node = nil
+	it.after = it.fromTail
+}
@@ -0,0 +1,172 @@
+package linkedlist
+
+import (
+	"reflect"
+	"testing"
+)
+
+// step :: struct :: one Iterator move and what it should report
+type step struct {
+	move  string
+	want  bool
+	value string
+}
+
+func TestIterator(t *testing.T) {
+	tests := []struct {
+		name   string
+		values []string
+		steps  []step
+	}{
+		{
+			name:  "empty list",
+			steps: []step{{"next", false, ""}, {"next", false, ""}},
+		},
+		{
+			name:   "walks head to tail then stops",
+			values: []string{"a", "b"},
+			steps: []step{
+				{"next", true, "a"},
+				{"next", true, "b"},
+				{"next", false, ""},
+				{"next", false, ""},
+			},
+		},
+		{
+			name:   "reset starts again from the head",
+			values: []string{"a", "b"},
+			steps: []step{
+				{"next", true, "a"},
+				{"next", true, "b"},
+				{"reset", false, ""},
+				{"next", true, "a"},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			it := NewSinglyLinked(tt.values...).Iterator()
+			for i, s := range tt.steps {
+				var got bool
+				switch s.move {
+				case "next":
+					got = it.Next()
+				case "reset":
+					it.Reset()
+					got = false
+				}
+				if got != s.want || it.Value() != s.value {
+					t.Errorf("step %d %s = %v, %q, want %v, %q", i, s.move, got, it.Value(), s.want, s.value)
+				}
+			}
+		})
+	}
+}
+
+func TestIterator_Independent(t *testing.T) {
+	l := NewSinglyLinked("a", "b", "c")
+	outer, inner := l.Iterator(), l.Iterator()
+	var pairs []string
+	for outer.Next() {
+		inner.Reset()
+		for inner.Next() {
+			pairs = append(pairs, outer.Value()+inner.Value())
+			// Neither of these should move either Iterator
+			l.Find("c")
+			l.AddNode()
+		}
+	}
+	want := []string{"aa", "ab", "ac", "ba", "bb", "bc", "ca", "cb", "cc"}
+	if !reflect.DeepEqual(pairs, want) {
+		t.Errorf("nested iteration = %v, want %v", pairs, want)
+	}
+
+	// Removing the Node an Iterator is on doesn't stop it moving on
+	var got []string
+	for it := l.Iterator(); it.Next(); {
+		if it.Value() == "b" {
+			l.Remove("b")
+		}
+		got = append(got, it.Value())
+	}
+	if want := []string{"a", "b", "c"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("iteration while removing = %v, want %v", got, want)
+	}
+	if _, found := l.Find("b"); found {
+		t.Errorf("removed value still in list")
+	}
+}
+
+func TestDoubleIterator(t *testing.T) {
+	tests := []struct {
+		name    string
+		values  []string
+		reverse bool
+		steps   []step
+	}{
+		{
+			name:  "empty list",
+			steps: []step{{"next", false, ""}, {"prev", false, ""}, {"next", false, ""}},
+		},
+		{
+			name:   "walks forward then back",
+			values: []string{"a", "b", "c"},
+			steps: []step{
+				{"prev", false, ""},
+				{"next", true, "a"},
+				{"next", true, "b"},
+				{"prev", true, "a"},
+				{"prev", false, ""},
+				{"next", true, "a"},
+			},
+		},
+		{
+			name:   "steps back onto the tail from past the end",
+			values: []string{"a", "b"},
+			steps: []step{
+				{"next", true, "a"},
+				{"next", true, "b"},
+				{"next", false, ""},
+				{"next", false, ""},
+				{"prev", true, "b"},
+			},
+		},
+		{
+			name:    "reverse iterator starts after the tail",
+			values:  []string{"a", "b"},
+			reverse: true,
+			steps: []step{
+				{"next", false, ""},
+				{"prev", true, "b"},
+				{"prev", true, "a"},
+				{"prev", false, ""},
+				{"reset", false, ""},
+				{"prev", true, "b"},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			l := NewDoublyLinked(tt.values...)
+			it := l.Iterator()
+			if tt.reverse {
+				it = l.ReverseIterator()
+			}
+			for i, s := range tt.steps {
+				var got bool
+				switch s.move {
+				case "next":
+					got = it.Next()
+				case "prev":
+					got = it.Prev()
+				case "reset":
+					it.Reset()
+					got = false
+				}
+				if got != s.want || it.Value() != s.value {
+					t.Errorf("step %d %s = %v, %q, want %v, %q", i, s.move, got, it.Value(), s.want, s.value)
+				}
+			}
+		})
+	}
+}
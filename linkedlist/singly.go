@@ -1,28 +1,36 @@
 package linkedlist
 
-import (
-	"errors"
-	"reflect"
-
-	"go-datastructures/model"
-)
-
 // SinglyLinkedList :: struct :: Singly-Linked LinkedList
-type SinglyLinkedList struct {
-	Current *Node
-	Head    *Node
+type SinglyLinkedList[T any] struct {
+	// Current is the position of the HasNext walk over the list.
+	//
+	// Deprecated: only HasNext moves Current, so it's shared by every loop over the list.
+	// Use an Iterator instead.
+	Current *Node[T]
+	Head    *Node[T]
+	// size counts the Nodes added and removed through the list's methods,
+	// Nodes linked onto Head by hand aren't included, see shrink
+	size  int
+	equal EqualFunc[T]
 }
 
-// Node :: struct :: Container struct for model.Object nodes
-type Node struct {
-	Value model.Object
-	Next  *Node
+// Node :: struct :: Container struct for list values
+type Node[T any] struct {
+	Value T
+	Next  *Node[T]
 }
 
 // NewSinglyLinked :: func :: Returns a pointer to a new SinglyLinkedList
-func NewSinglyLinked(values ...string) (l *SinglyLinkedList) {
-	l = &SinglyLinkedList{}
-	if values != nil && len(values) > 0 {
+// whose values are compared with ==
+func NewSinglyLinked[T comparable](values ...T) (l *SinglyLinkedList[T]) {
+	return NewSinglyLinkedFunc(comparableEqual[T], values...)
+}
+
+// NewSinglyLinkedFunc :: func :: Returns a pointer to a new SinglyLinkedList
+// whose values are compared with the supplied EqualFunc
+func NewSinglyLinkedFunc[T any](equal EqualFunc[T], values ...T) (l *SinglyLinkedList[T]) {
+	l = &SinglyLinkedList[T]{equal: equal}
+	if len(values) > 0 {
 		l.AddNode(BuildSingleNodes(values)...)
 	}
 	return l
@@ -32,65 +40,113 @@ func NewSinglyLinked(values ...string) (l *SinglyLinkedList) {
 // - Previous Head still points towards it's own Next
 // - New Node being added to the front has the Next point towards the previous Head (in constructor)
 // - List then accepts the new node as the current Head
-func (l *SinglyLinkedList) Add(obj model.Object) {
-	newItem := &Node{
+func (l *SinglyLinkedList[T]) Add(obj T) {
+	newItem := &Node[T]{
 		Value: obj,
 		Next:  l.Head,
 	}
 	l.Head = newItem
-	// l.Current = newItem
+	l.size++
 }
 
-// Find :: func :: Find an object in the list
-func (l *SinglyLinkedList) Find(obj model.Object) (model.Object, bool) {
-	if _, found := l.FindNode(obj); found {
-		return l.Current.Value, found
-	}
-	return model.Object{}, false
+// Len :: func :: Returns the number of values in the list, without walking it.
+// Only values added through the list's methods are counted, not Nodes linked onto Head by hand.
+func (l *SinglyLinkedList[T]) Len() int {
+	return l.size
 }
 
-func (l *SinglyLinkedList) FindNode(obj model.Object) (*Node, bool) {
-	l.Current = l.Head
-	if l.Current == nil {
-		return nil, false
+// IsEmpty :: func :: Reports whether the list holds no values
+func (l *SinglyLinkedList[T]) IsEmpty() bool {
+	return l.Head == nil
+}
+
+// shrink :: func :: Counts a Node removed from the list. Removing a Node that was linked by hand,
+// and so never counted, leaves size at 0 rather than taking it negative.
+func (l *SinglyLinkedList[T]) shrink() {
+	if l.size > 0 {
+		l.size--
 	}
-	// Check first element manually, since HasNext will advance Current
-	if firstMatch := reflect.DeepEqual(l.Current.Value, obj); firstMatch {
-		return l.Current, firstMatch
+}
+
+// Find :: func :: Find an object in the list
+func (l *SinglyLinkedList[T]) Find(obj T) (T, bool) {
+	if node, found := l.FindNode(obj); found {
+		return node.Value, true
 	}
-	// Iterate through rest of list
-	for l.HasNext() {
-		if reflect.DeepEqual(l.Current.Value, obj) {
-			return l.Current, true
+	var zero T
+	return zero, false
+}
+
+// FindNode :: func :: Find the first Node holding a value equal to obj
+func (l *SinglyLinkedList[T]) FindNode(obj T) (*Node[T], bool) {
+	for node := l.Head; node != nil; node = node.Next {
+		if equals(l.equal, node.Value, obj) {
+			return node, true
 		}
 	}
 	return nil, false
 }
 
 // Remove :: func :: Remove an object from the list
-func (l *SinglyLinkedList) Remove(obj model.Object) error {
-	l.Current = l.Head
-	var previous *Node
-	for l.Current != nil {
-		if reflect.DeepEqual(l.Current.Value, obj) {
+func (l *SinglyLinkedList[T]) Remove(obj T) error {
+	var previous *Node[T]
+	for node := l.Head; node != nil; node = node.Next {
+		if equals(l.equal, node.Value, obj) {
 			if previous != nil {
-				previous.Next = l.Current.Next
+				previous.Next = node.Next
 			} else {
-				l.Head = nil
-				l.Current = nil
+				// Removing the Head, the rest of the list stays linked
+				l.Head = node.Next
 			}
+			l.shrink()
 			return nil
 		}
-		previous = l.Current
-		l.Current = l.Current.Next
+		previous = node
+	}
+	return ErrNotFound
+}
+
+// RemoveHead :: func :: Removes the value at the Head of the list, returning it.
+// Unlike Remove it doesn't compare values, so it takes the Head even when another Node holds an equal value.
+func (l *SinglyLinkedList[T]) RemoveHead() (T, error) {
+	head := l.Head
+	if head == nil {
+		var zero T
+		return zero, ErrEmpty
+	}
+	l.Head = head.Next
+	l.shrink()
+	return head.Value, nil
+}
+
+// RemoveTail :: func :: Removes the value at the end of the list, returning it.
+// A SinglyLinkedList doesn't keep track of its last Node, so this walks the whole list to find the one before it.
+func (l *SinglyLinkedList[T]) RemoveTail() (T, error) {
+	if l.Head == nil {
+		var zero T
+		return zero, ErrEmpty
 	}
-	return errors.New("object not found in list")
+	var previous *Node[T]
+	last := l.Head
+	for last.Next != nil {
+		previous, last = last, last.Next
+	}
+	if previous != nil {
+		previous.Next = nil
+	} else {
+		l.Head = nil
+	}
+	l.shrink()
+	return last.Value, nil
 }
 
 // HasNext :: func :: returns true if the next Node is not nil
 // Since this is being use to iterate over lists, it also
 // advances the Current marker.
-func (l *SinglyLinkedList) HasNext() bool {
+//
+// Deprecated: every HasNext loop over a list shares its Current marker, so two loops
+// can't walk the same list at once. Use an Iterator instead.
+func (l *SinglyLinkedList[T]) HasNext() bool {
 	// Check if Current isn't set
 	if l.Current == nil {
 		l.Current = l.Head
@@ -107,36 +163,29 @@ func (l *SinglyLinkedList) HasNext() bool {
 }
 
 // AddNode :: func :: Helper function to build list or add new nodes to existing list
-func (l *SinglyLinkedList) AddNode(n ...*Node) {
-	// Determine position in list before iterating
-	if l.Current == nil {
-		l.Current = l.Head
-	}
-	if l.Current != nil {
-		// Advance to last link
-		for l.HasNext() {
-
-		}
+func (l *SinglyLinkedList[T]) AddNode(n ...*Node[T]) {
+	// Advance to last link
+	last := l.Head
+	for last != nil && last.Next != nil {
+		last = last.Next
 	}
-	// Current has been set, iterate to add Nodes
-	for i, node := range n {
-		if i == 0 && l.Current == nil {
-			// Set current if list is empty
-			l.Current = node
+	for _, node := range n {
+		if last == nil {
+			// List is empty, the first Node becomes the Head
 			l.Head = node
-			continue
 		} else {
-			l.Current.Next = node
-			l.Current = node
+			last.Next = node
 		}
+		last = node
 	}
+	l.size += len(n)
 }
 
 // BuildSingleNodes :: func :: Helper function to build Nodes
-func BuildSingleNodes(in []string) []*Node {
-	var out []*Node
+func BuildSingleNodes[T any](in []T) []*Node[T] {
+	var out []*Node[T]
 	for _, val := range in {
-		out = append(out, &Node{Value: model.Object{Value: val}})
+		out = append(out, &Node[T]{Value: val})
 	}
 	return out
 }
@@ -1,7 +1,7 @@
 package linkedlist
 
 import (
-	"go-datastructures/model"
+	"slices"
 	"testing"
 )
 
@@ -56,9 +56,9 @@ func TestSinglyLinkedList_Find(t *testing.T) {
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			l := &SinglyLinkedList{}
+			l := &SinglyLinkedList[string]{}
 			l.AddNode(BuildSingleNodes(tt.values)...)
-			obj := model.Object{Value: tt.search}
+			obj := tt.search
 			if _, found := l.Find(obj); found != tt.found {
 				t.Errorf("SinglyLinkedList.Find() object not found in list")
 			}
@@ -68,11 +68,11 @@ func TestSinglyLinkedList_Find(t *testing.T) {
 
 func TestSinglyLinkedList_Add(t *testing.T) {
 	type fields struct {
-		Current *Node
-		Head    *Node
+		Current *Node[string]
+		Head    *Node[string]
 	}
 	type args struct {
-		obj model.Object
+		obj string
 	}
 	tests := []struct {
 		name    string
@@ -87,21 +87,21 @@ func TestSinglyLinkedList_Add(t *testing.T) {
 				Head:    nil,
 			},
 			args: args{
-				obj: model.Object{Value: "thing"},
+				obj: "thing",
 			},
 			wantErr: false,
 		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			l := &SinglyLinkedList{
+			l := &SinglyLinkedList[string]{
 				Current: tt.fields.Current,
 				Head:    tt.fields.Head,
 			}
 			l.Add(tt.args.obj)
 			_, found := l.Find(tt.args.obj)
 			if !found {
-				t.Errorf("SinglyLinkedList.Add() failure = %v not found after call to Add()", tt.args.obj.Value)
+				t.Errorf("SinglyLinkedList.Add() failure = %v not found after call to Add()", tt.args.obj)
 			}
 		})
 	}
@@ -109,8 +109,8 @@ func TestSinglyLinkedList_Add(t *testing.T) {
 
 func TestSinglyLinkedList_Remove(t *testing.T) {
 	type fields struct {
-		Current *Node
-		Head    *Node
+		Current *Node[string]
+		Head    *Node[string]
 	}
 	tests := []struct {
 		name      string
@@ -127,10 +127,10 @@ func TestSinglyLinkedList_Remove(t *testing.T) {
 		{
 			name: "node not found: error",
 			fields: fields{
-				Head: &Node{
-					Value: model.Object{Value: "first"},
-					Next: &Node{
-						Value: model.Object{Value: "second"},
+				Head: &Node[string]{
+					Value: "first",
+					Next: &Node[string]{
+						Value: "second",
 						Next:  nil,
 					},
 				},
@@ -141,10 +141,10 @@ func TestSinglyLinkedList_Remove(t *testing.T) {
 		{
 			name: "node is removed",
 			fields: fields{
-				Head: &Node{
-					Value: model.Object{Value: "first"},
-					Next: &Node{
-						Value: model.Object{Value: "second"},
+				Head: &Node[string]{
+					Value: "first",
+					Next: &Node[string]{
+						Value: "second",
 						Next:  nil,
 					},
 				},
@@ -152,16 +152,31 @@ func TestSinglyLinkedList_Remove(t *testing.T) {
 			value:   "second",
 			wantErr: false,
 		},
+		{
+			name: "head node is removed and the rest of the list is kept",
+			fields: fields{
+				Head: &Node[string]{
+					Value: "first",
+					Next: &Node[string]{
+						Value: "second",
+						Next:  nil,
+					},
+				},
+			},
+			value:     "first",
+			nextValue: "second",
+			wantErr:   false,
+		},
 		{
 			name: "node middle node is removed and links are preserved",
 			fields: fields{
-				Head: &Node{
-					Value: model.Object{Value: "first"},
-					Next: &Node{
-						Value: model.Object{Value: "second"},
+				Head: &Node[string]{
+					Value: "first",
+					Next: &Node[string]{
+						Value: "second",
 						// It's thirdles all the way down
-						Next: &Node{
-							Value: model.Object{Value: "third"},
+						Next: &Node[string]{
+							Value: "third",
 							Next:  nil,
 						},
 					},
@@ -173,12 +188,12 @@ func TestSinglyLinkedList_Remove(t *testing.T) {
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			l := &SinglyLinkedList{
+			l := &SinglyLinkedList[string]{
 				Current: tt.fields.Current,
 				Head:    tt.fields.Head,
 			}
-			obj := model.Object{Value: tt.value}
-			nextObj := model.Object{Value: tt.nextValue}
+			obj := tt.value
+			nextObj := tt.nextValue
 			if err := l.Remove(obj); (err != nil) != tt.wantErr {
 				t.Errorf("SinglyLinkedList.Remove() error = %v, wantErr %v", err, tt.wantErr)
 			}
@@ -188,7 +203,7 @@ func TestSinglyLinkedList_Remove(t *testing.T) {
 			}
 			if tt.nextValue != "" {
 				_, found := l.Find(nextObj)
-				if found {
+				if !found {
 					t.Errorf("Remove() broke the links in the chain; missing next: %v", nextObj)
 				}
 			}
@@ -198,8 +213,8 @@ func TestSinglyLinkedList_Remove(t *testing.T) {
 
 func TestSinglyLinkedList_HasNext(t *testing.T) {
 	type fields struct {
-		Current *Node
-		Head    *Node
+		Current *Node[string]
+		Head    *Node[string]
 	}
 	tests := []struct {
 		name   string
@@ -210,7 +225,7 @@ func TestSinglyLinkedList_HasNext(t *testing.T) {
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			l := &SinglyLinkedList{
+			l := &SinglyLinkedList[string]{
 				Current: tt.fields.Current,
 				Head:    tt.fields.Head,
 			}
@@ -220,3 +235,154 @@ func TestSinglyLinkedList_HasNext(t *testing.T) {
 		})
 	}
 }
+
+type record struct {
+	ID   int
+	Tags []string
+}
+
+func TestNewSinglyLinkedFunc(t *testing.T) {
+	byID := func(a, b record) bool { return a.ID == b.ID }
+	tests := []struct {
+		name   string
+		values []record
+		search record
+		found  bool
+	}{
+		{
+			name:   "empty list returns false",
+			search: record{ID: 1},
+		},
+		{
+			name: "non-comparable value is found using the supplied EqualFunc",
+			values: []record{
+				{ID: 1, Tags: []string{"first"}},
+				{ID: 2, Tags: []string{"second"}},
+			},
+			search: record{ID: 2},
+			found:  true,
+		},
+		{
+			name: "non-comparable value not found",
+			values: []record{
+				{ID: 1, Tags: []string{"first"}},
+			},
+			search: record{ID: 3},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			l := NewSinglyLinkedFunc(byID, tt.values...)
+			got, found := l.Find(tt.search)
+			if found != tt.found {
+				t.Errorf("SinglyLinkedList.Find() found = %v, want %v", found, tt.found)
+			}
+			if found && got.ID != tt.search.ID {
+				t.Errorf("SinglyLinkedList.Find() = %v, want ID %v", got, tt.search.ID)
+			}
+			if found {
+				if err := l.Remove(tt.search); err != nil {
+					t.Errorf("SinglyLinkedList.Remove() error = %v", err)
+				}
+			}
+		})
+	}
+}
+
+func TestSinglyLinkedList_Len(t *testing.T) {
+	l := NewSinglyLinked("a", "b")
+	steps := []struct {
+		name string
+		op   func()
+		want int
+	}{
+		{name: "built from values", op: func() {}, want: 2},
+		{name: "Add", op: func() { l.Add("c") }, want: 3},
+		{name: "AddNode", op: func() { l.AddNode(BuildSingleNodes([]string{"d", "e"})...) }, want: 5},
+		{name: "Remove", op: func() { l.Remove("a") }, want: 4},
+		{name: "Remove missing value", op: func() { l.Remove("z") }, want: 4},
+		{name: "InsertAt", op: func() { l.InsertAt(1, "f") }, want: 5},
+		{name: "RemoveAt", op: func() { l.RemoveAt(0) }, want: 4},
+		{name: "RemoveAt out of range", op: func() { l.RemoveAt(10) }, want: 4},
+		{name: "RemoveHead", op: func() { l.RemoveHead() }, want: 3},
+		{name: "RemoveTail", op: func() { l.RemoveTail() }, want: 2},
+	}
+	for _, s := range steps {
+		s.op()
+		walked := 0
+		for it := l.Iterator(); it.Next(); {
+			walked++
+		}
+		if got := l.Len(); got != s.want || got != walked {
+			t.Errorf("after %s Len() = %d, want %d (walked %d)", s.name, got, s.want, walked)
+		}
+	}
+	for l.Head != nil {
+		l.Remove(l.Head.Value)
+	}
+	if l.Len() != 0 || !l.IsEmpty() {
+		t.Errorf("emptied list Len() = %d, IsEmpty() = %v", l.Len(), l.IsEmpty())
+	}
+}
+
+func TestSinglyLinkedList_Len_HandBuilt(t *testing.T) {
+	// Nodes linked onto Head by hand aren't counted, but removing them mustn't take Len below 0
+	l := &SinglyLinkedList[string]{Head: &Node[string]{Value: "a", Next: &Node[string]{Value: "b"}}}
+	if l.IsEmpty() {
+		t.Errorf("IsEmpty() = true with a Head")
+	}
+	l.Add("c")
+	for _, remove := range []func(){
+		func() { l.RemoveHead() },
+		func() { l.Remove("a") },
+		func() { l.RemoveTail() },
+	} {
+		remove()
+		if l.Len() < 0 {
+			t.Errorf("Len() = %d", l.Len())
+		}
+	}
+	if l.Len() != 0 || !l.IsEmpty() {
+		t.Errorf("emptied list Len() = %d, IsEmpty() = %v", l.Len(), l.IsEmpty())
+	}
+}
+
+func TestSinglyLinkedList_RemoveHeadTail(t *testing.T) {
+	tests := []struct {
+		name    string
+		values  []string
+		tail    bool
+		want    string
+		wantErr error
+		rest    []string
+	}{
+		{name: "head of empty list", wantErr: ErrEmpty},
+		{name: "tail of empty list", tail: true, wantErr: ErrEmpty},
+		{name: "head of one value", values: []string{"a"}, want: "a"},
+		{name: "tail of one value", values: []string{"a"}, tail: true, want: "a"},
+		{name: "head", values: []string{"a", "b", "c"}, want: "a", rest: []string{"b", "c"}},
+		{name: "tail", values: []string{"a", "b", "c"}, tail: true, want: "c", rest: []string{"a", "b"}},
+		{name: "head with duplicates", values: []string{"a", "b", "a"}, want: "a", rest: []string{"b", "a"}},
+		{name: "tail with duplicates", values: []string{"a", "b", "a"}, tail: true, want: "a", rest: []string{"a", "b"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			l := NewSinglyLinked(tt.values...)
+			remove := l.RemoveHead
+			if tt.tail {
+				remove = l.RemoveTail
+			}
+			got, err := remove()
+			if got != tt.want || err != tt.wantErr {
+				t.Errorf("got %q, %v, want %q, %v", got, err, tt.want, tt.wantErr)
+			}
+			var rest []string
+			for it := l.Iterator(); it.Next(); {
+				rest = append(rest, it.Value())
+			}
+			if !slices.Equal(rest, tt.rest) || l.Len() != len(tt.rest) {
+				t.Errorf("list holds %v with Len() = %d, want %v", rest, l.Len(), tt.rest)
+			}
+		})
+	}
+}
@@ -0,0 +1,177 @@
+package lru
+
+import (
+	"go-datastructures/hashtable"
+	"go-datastructures/linkedlist"
+	"time"
+)
+
+// Cache :: struct :: Fixed-capacity cache that evicts the least recently used entry to make room.
+// Entries are kept in a DoublyLinkedList from most to least recently used, with a HashTable
+// from each key to its Node so that Get, Put and Remove never search the list: a hit moves the
+// Node to the Head, an eviction unlinks the Tail, both in O(1).
+//
+// With WithTTL, entries also expire a fixed time after they were last Put. Expired entries are
+// dropped when they're next looked up, or evicted like any other once they reach the Tail,
+// so until then they still count towards Len.
+type Cache[K comparable, V any] struct {
+	capacity int
+	order    *linkedlist.DoublyLinkedList[entry[K, V]]
+	index    *hashtable.HashTable[K, *linkedlist.DoubleNode[entry[K, V]]]
+	onEvict  func(key K, value V)
+	ttl      time.Duration
+	now      func() time.Time
+}
+
+// entry :: struct :: list value, the key is kept alongside the value so an evicted Tail can be
+// deleted from the index
+type entry[K comparable, V any] struct {
+	key     K
+	value   V
+	expires time.Time
+}
+
+// New :: func :: Returns a pointer to a new Cache holding up to capacity entries, configured by opts
+func New[K comparable, V any](capacity int, opts ...Option[K, V]) *Cache[K, V] {
+	if capacity < 1 {
+		panic("lru: capacity must be at least 1")
+	}
+	c := config[K, V]{now: time.Now}
+	for _, opt := range opts {
+		opt(&c)
+	}
+	cache := &Cache[K, V]{
+		capacity: capacity,
+		// Entries are only ever found through the index, but compare keys in case anyone asks the list
+		order: linkedlist.NewDoublyLinkedFunc(func(a, b entry[K, V]) bool {
+			return a.key == b.key
+		}),
+		index:   hashtable.New[K, *linkedlist.DoubleNode[entry[K, V]]](),
+		onEvict: c.onEvict,
+		ttl:     c.ttl,
+		now:     c.now,
+	}
+	return cache
+}
+
+// Get :: func :: Returns the value stored under key, and whether there was one,
+// marking it as the most recently used
+func (c *Cache[K, V]) Get(key K) (V, bool) {
+	node, found := c.lookup(key)
+	if !found {
+		var zero V
+		return zero, false
+	}
+	c.order.MoveToFront(node)
+	return node.Value.value, true
+}
+
+// Peek :: func :: Returns the value stored under key, and whether there was one,
+// without marking it as used or dropping it if it has expired
+func (c *Cache[K, V]) Peek(key K) (V, bool) {
+	node, found := c.index.Get(key)
+	if !found || c.expired(node.Value) {
+		var zero V
+		return zero, false
+	}
+	return node.Value.value, true
+}
+
+// Put :: func :: Stores value under key as the most recently used entry, evicting the
+// least recently used one if the Cache is full. Replacing a value restarts its TTL.
+func (c *Cache[K, V]) Put(key K, value V) {
+	e := entry[K, V]{key: key, value: value}
+	if c.ttl > 0 {
+		e.expires = c.now().Add(c.ttl)
+	}
+	if node, found := c.index.Get(key); found {
+		node.Value = e
+		c.order.MoveToFront(node)
+		return
+	}
+	c.index.Put(key, c.order.AddHead(e))
+	if c.order.Len() > c.capacity {
+		c.evict(c.order.Tail)
+	}
+}
+
+// Remove :: func :: Removes key from the Cache, returning false if it wasn't present.
+// OnEvict isn't called for entries removed this way.
+func (c *Cache[K, V]) Remove(key K) bool {
+	node, found := c.index.Get(key)
+	if !found {
+		return false
+	}
+	c.drop(node)
+	return true
+}
+
+// Len :: func :: Returns the number of entries in the Cache
+func (c *Cache[K, V]) Len() int {
+	return c.order.Len()
+}
+
+// lookup :: func :: finds the Node for key, evicting it instead if it has expired
+func (c *Cache[K, V]) lookup(key K) (*linkedlist.DoubleNode[entry[K, V]], bool) {
+	node, found := c.index.Get(key)
+	if !found {
+		return nil, false
+	}
+	if c.expired(node.Value) {
+		c.evict(node)
+		return nil, false
+	}
+	return node, true
+}
+
+func (c *Cache[K, V]) expired(e entry[K, V]) bool {
+	return c.ttl > 0 && !c.now().Before(e.expires)
+}
+
+// evict :: func :: drops node from the Cache and tells OnEvict about it
+func (c *Cache[K, V]) evict(node *linkedlist.DoubleNode[entry[K, V]]) {
+	c.drop(node)
+	if c.onEvict != nil {
+		c.onEvict(node.Value.key, node.Value.value)
+	}
+}
+
+// drop :: func :: takes node out of both the list and the index
+func (c *Cache[K, V]) drop(node *linkedlist.DoubleNode[entry[K, V]]) {
+	c.order.Unlink(node)
+	c.index.Delete(node.Value.key)
+}
+
+// Option :: func :: Configures a Cache created with New
+type Option[K comparable, V any] func(*config[K, V])
+
+type config[K comparable, V any] struct {
+	onEvict func(key K, value V)
+	ttl     time.Duration
+	now     func() time.Time
+}
+
+// WithOnEvict :: func :: Calls f with each entry the Cache evicts, whether to make room or
+// because it expired
+func WithOnEvict[K comparable, V any](f func(key K, value V)) Option[K, V] {
+	return func(c *config[K, V]) {
+		c.onEvict = f
+	}
+}
+
+// WithTTL :: func :: Expires entries ttl after they were last Put
+func WithTTL[K comparable, V any](ttl time.Duration) Option[K, V] {
+	return func(c *config[K, V]) {
+		if ttl <= 0 {
+			panic("lru: TTL must be greater than 0")
+		}
+		c.ttl = ttl
+	}
+}
+
+// WithClock :: func :: Reads the time from now instead of time.Now, for testing TTL expiry
+func WithClock[K comparable, V any](now func() time.Time) Option[K, V] {
+	return func(c *config[K, V]) {
+		c.now = now
+	}
+}
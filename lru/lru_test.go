@@ -0,0 +1,258 @@
+package lru
+
+import (
+	"math/rand"
+	"reflect"
+	"slices"
+	"testing"
+	"time"
+)
+
+// keys :: func :: returns the Cache's keys from most to least recently used,
+// checking the list and the index agree with each other
+func keys[K comparable, V any](t *testing.T, c *Cache[K, V]) []K {
+	t.Helper()
+	var out []K
+	for it := c.order.Iterator(); it.Next(); {
+		e := it.Value()
+		out = append(out, e.key)
+		if node, found := c.index.Get(e.key); !found || node.Value.key != e.key {
+			t.Errorf("key %v is in the list but not the index", e.key)
+		}
+	}
+	if c.index.Len() != len(out) || c.Len() != len(out) {
+		t.Errorf("index holds %d keys and Len() = %d, but the list holds %d", c.index.Len(), c.Len(), len(out))
+	}
+	return out
+}
+
+func TestCache(t *testing.T) {
+	tests := []struct {
+		name     string
+		capacity int
+		ops      func(c *Cache[string, int])
+		want     []string
+		evicted  []string
+	}{
+		{
+			name:     "puts up to capacity",
+			capacity: 3,
+			ops: func(c *Cache[string, int]) {
+				c.Put("a", 1)
+				c.Put("b", 2)
+				c.Put("c", 3)
+			},
+			want: []string{"c", "b", "a"},
+		},
+		{
+			name:     "least recently put is evicted",
+			capacity: 2,
+			ops: func(c *Cache[string, int]) {
+				c.Put("a", 1)
+				c.Put("b", 2)
+				c.Put("c", 3)
+			},
+			want:    []string{"c", "b"},
+			evicted: []string{"a"},
+		},
+		{
+			name:     "get marks an entry as used",
+			capacity: 2,
+			ops: func(c *Cache[string, int]) {
+				c.Put("a", 1)
+				c.Put("b", 2)
+				c.Get("a")
+				c.Put("c", 3)
+			},
+			want:    []string{"c", "a"},
+			evicted: []string{"b"},
+		},
+		{
+			name:     "peek doesn't",
+			capacity: 2,
+			ops: func(c *Cache[string, int]) {
+				c.Put("a", 1)
+				c.Put("b", 2)
+				c.Peek("a")
+				c.Put("c", 3)
+			},
+			want:    []string{"c", "b"},
+			evicted: []string{"a"},
+		},
+		{
+			name:     "replacing a value marks it as used without evicting",
+			capacity: 2,
+			ops: func(c *Cache[string, int]) {
+				c.Put("a", 1)
+				c.Put("b", 2)
+				c.Put("a", 10)
+				c.Put("c", 3)
+			},
+			want:    []string{"c", "a"},
+			evicted: []string{"b"},
+		},
+		{
+			name:     "removed entries aren't evicted",
+			capacity: 2,
+			ops: func(c *Cache[string, int]) {
+				c.Put("a", 1)
+				c.Put("b", 2)
+				c.Remove("a")
+				c.Remove("z")
+				c.Put("c", 3)
+			},
+			want: []string{"c", "b"},
+		},
+		{
+			name:     "capacity of one",
+			capacity: 1,
+			ops: func(c *Cache[string, int]) {
+				c.Put("a", 1)
+				c.Put("b", 2)
+				c.Put("c", 3)
+			},
+			want:    []string{"c"},
+			evicted: []string{"a", "b"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var evicted []string
+			c := New[string, int](tt.capacity, WithOnEvict(func(key string, _ int) {
+				evicted = append(evicted, key)
+			}))
+			tt.ops(c)
+			if got := keys(t, c); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Cache holds %v, want %v", got, tt.want)
+			}
+			if !reflect.DeepEqual(evicted, tt.evicted) {
+				t.Errorf("OnEvict called with %v, want %v", evicted, tt.evicted)
+			}
+		})
+	}
+}
+
+func TestCache_GetPeekRemove(t *testing.T) {
+	c := New[string, int](2)
+	c.Put("a", 1)
+	c.Put("a", 2)
+	for name, get := range map[string]func(string) (int, bool){"Get": c.Get, "Peek": c.Peek} {
+		if got, found := get("a"); got != 2 || !found {
+			t.Errorf("%s(a) = %v, %v, want 2, true", name, got, found)
+		}
+		if got, found := get("z"); got != 0 || found {
+			t.Errorf("%s(z) = %v, %v, want 0, false", name, got, found)
+		}
+	}
+	if !c.Remove("a") || c.Remove("a") {
+		t.Errorf("Remove(a) should succeed once")
+	}
+	if _, found := c.Get("a"); found || c.Len() != 0 {
+		t.Errorf("Get(a) found a removed entry, Len() = %d", c.Len())
+	}
+}
+
+func TestCache_TTL(t *testing.T) {
+	now := time.Unix(0, 0)
+	var evicted []string
+	c := New[string, int](10,
+		WithTTL[string, int](time.Minute),
+		WithClock[string, int](func() time.Time { return now }),
+		WithOnEvict(func(key string, _ int) { evicted = append(evicted, key) }),
+	)
+	c.Put("a", 1)
+	now = now.Add(30 * time.Second)
+	c.Put("b", 2)
+	if _, found := c.Get("a"); !found {
+		t.Errorf("Get(a) before its TTL ran out found nothing")
+	}
+	now = now.Add(30 * time.Second)
+	// a is due now, and Peek doesn't show it but leaves it in place
+	if _, found := c.Peek("a"); found {
+		t.Errorf("Peek(a) found an expired entry")
+	}
+	if c.Len() != 2 {
+		t.Errorf("Len() = %d after Peek(), want 2", c.Len())
+	}
+	if _, found := c.Get("a"); found {
+		t.Errorf("Get(a) found an expired entry")
+	}
+	if got := keys(t, c); !reflect.DeepEqual(got, []string{"b"}) {
+		t.Errorf("Cache holds %v after expiry, want [b]", got)
+	}
+	// Putting b again restarts its TTL
+	c.Put("b", 3)
+	now = now.Add(45 * time.Second)
+	if got, found := c.Get("b"); got != 3 || !found {
+		t.Errorf("Get(b) = %v, %v, want 3, true", got, found)
+	}
+	if !reflect.DeepEqual(evicted, []string{"a"}) {
+		t.Errorf("OnEvict called with %v, want [a]", evicted)
+	}
+}
+
+func TestCache_Options(t *testing.T) {
+	tests := []struct {
+		name     string
+		capacity int
+		opts     []Option[string, int]
+	}{
+		{name: "zero capacity", capacity: 0},
+		{name: "zero TTL", capacity: 1, opts: []Option[string, int]{WithTTL[string, int](0)}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			defer func() {
+				if recover() == nil {
+					t.Errorf("New() did not panic")
+				}
+			}()
+			New[string, int](tt.capacity, tt.opts...)
+		})
+	}
+}
+
+func TestCache_Random(t *testing.T) {
+	const capacity = 16
+	rng := rand.New(rand.NewSource(1))
+	c := New[int, int](capacity)
+	// The reference model: keys from most to least recently used, and their values
+	var recent []int
+	values := map[int]int{}
+	touch := func(key int) {
+		recent = slices.DeleteFunc(recent, func(k int) bool { return k == key })
+		recent = append([]int{key}, recent...)
+	}
+	for i := 0; i < 5000; i++ {
+		key := rng.Intn(40)
+		switch rng.Intn(4) {
+		case 0:
+			got, found := c.Get(key)
+			want, wantFound := values[key]
+			if got != want || found != wantFound {
+				t.Fatalf("Get(%d) = %v, %v, want %v, %v", key, got, found, want, wantFound)
+			}
+			if found {
+				touch(key)
+			}
+		case 1:
+			removed := c.Remove(key)
+			if _, want := values[key]; removed != want {
+				t.Fatalf("Remove(%d) = %v, want %v", key, removed, want)
+			}
+			delete(values, key)
+			recent = slices.DeleteFunc(recent, func(k int) bool { return k == key })
+		default:
+			c.Put(key, i)
+			values[key] = i
+			touch(key)
+			if len(recent) > capacity {
+				delete(values, recent[capacity])
+				recent = recent[:capacity]
+			}
+		}
+		if got := keys(t, c); !slices.Equal(got, recent) {
+			t.Fatalf("after operation %d Cache holds %v, want %v", i, got, recent)
+		}
+	}
+}
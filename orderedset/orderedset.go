@@ -0,0 +1,19 @@
+package orderedset
+
+// OrderedSet :: interface :: A set of values kept in sort order, satisfied by bst.BST, avl.AVL
+// and redblack.RedBlack. Code that only needs set operations can take an OrderedSet and
+// leave the choice of tree to the caller.
+//
+// Values that compare equal are the same member: adding one replaces the stored value.
+// Ascend and Descend call f until it returns false, so they can also be ranged over as iter.Seq.
+// treetest.RunSet checks an implementation against this contract.
+type OrderedSet[T any] interface {
+	Add(t T)
+	Remove(t T) (bool, error)
+	Contains(t T) bool
+	Len() int
+	Min() (T, bool)
+	Max() (T, bool)
+	Ascend(f func(t T) bool)
+	Descend(f func(t T) bool)
+}
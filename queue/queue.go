@@ -3,43 +3,142 @@ package queue
 import (
 	"errors"
 	"go-datastructures/linkedlist"
-	"go-datastructures/model"
+	"go-datastructures/ringbuffer"
 )
 
 // Queue :: struct :: FILO collection
-type Queue struct {
-	List *linkedlist.DoublyLinkedList
+//
+// By default values are kept in List, one DoubleNode each. A Queue created with the
+// WithRingBuffer option keeps them in a growable RingBuffer instead, which saves an allocation
+// per value and keeps them next to each other in memory. List is left empty on such a Queue,
+// so code that reads List directly sees no values rather than a nil List; the Queue's own
+// methods work with either.
+type Queue[T any] struct {
+	// List holds the values, or is empty and unused if the Queue was created WithRingBuffer
+	List *linkedlist.DoublyLinkedList[T]
+	// ring holds the values instead of List when it's set, compared with equal
+	ring  *ringbuffer.RingBuffer[T]
+	equal linkedlist.EqualFunc[T]
 }
 
 // New :: func :: Returns pointer to a new Queue
-func New(values ...string) *Queue {
+func New[T comparable](values ...T) *Queue[T] {
 	l := linkedlist.NewDoublyLinked(values...)
-	return &Queue{
+	return &Queue[T]{
 		List: l,
 	}
 }
 
+// NewFunc :: func :: Returns pointer to a new Queue whose values are compared with equal
+func NewFunc[T any](equal linkedlist.EqualFunc[T], values ...T) *Queue[T] {
+	l := linkedlist.NewDoublyLinkedFunc(equal, values...)
+	return &Queue[T]{
+		List: l,
+	}
+}
+
+// NewWith :: func :: Returns pointer to a new, empty Queue configured by opts
+func NewWith[T comparable](opts ...Option) *Queue[T] {
+	return NewFuncWith(func(a, b T) bool { return a == b }, opts...)
+}
+
+// NewFuncWith :: func :: Returns pointer to a new, empty Queue whose values are compared with equal,
+// configured by opts. As with NewFunc, a nil equal compares values with ==.
+func NewFuncWith[T any](equal linkedlist.EqualFunc[T], opts ...Option) *Queue[T] {
+	var c config
+	for _, opt := range opts {
+		opt(&c)
+	}
+	if !c.ring {
+		return NewFunc(equal)
+	}
+	return &Queue[T]{
+		List:  linkedlist.NewDoublyLinkedFunc(equal),
+		ring:  ringbuffer.New[T](c.capacity),
+		equal: equal,
+	}
+}
+
 // Dequeue :: func :: returns the first value in the Queue,
 // and removes that value from the embedded LinkedList
-func (q *Queue) Dequeue() (model.Object, error) {
-	if q.List.Head != nil {
-		val := q.List.Head.Value
-		return val, q.List.Remove(val)
+func (q *Queue[T]) Dequeue() (T, error) {
+	if q.ring != nil {
+		if val, ok := q.ring.PopFront(); ok {
+			return val, nil
+		}
+	} else if q.List.Head != nil {
+		return q.List.RemoveHead()
 	}
-	return model.Object{}, errors.New("queue is empty")
+	var zero T
+	return zero, errors.New("queue is empty")
 }
 
 // Add :: func :: Adds a value to the Queue in last position
-func (q *Queue) Add(obj model.Object) {
+func (q *Queue[T]) Add(obj T) {
+	if q.ring != nil {
+		q.ring.PushBack(obj)
+		return
+	}
 	q.List.AddTail(obj)
 }
 
 // Remove :: func :: Removes a value from the Queue
-func (q *Queue) Remove(obj model.Object) error {
+func (q *Queue[T]) Remove(obj T) error {
+	if q.ring != nil {
+		for i := 0; i < q.ring.Len(); i++ {
+			if val, _ := q.ring.At(i); q.equal.Equal(val, obj) {
+				q.ring.RemoveAt(i)
+				return nil
+			}
+		}
+		return linkedlist.ErrNotFound
+	}
 	return q.List.Remove(obj)
 }
 
-// Peek :: func :: Returns the Queue's current value
-func (q *Queue) Peek() model.Object {
-	return q.List.Current.Value
+// Peek :: func :: Returns the value at the front of the Queue, the next to be dequeued,
+// or the zero value if the Queue is empty
+func (q *Queue[T]) Peek() T {
+	if q.ring != nil {
+		val, _ := q.ring.Front()
+		return val
+	}
+	if q.List.Head == nil {
+		var zero T
+		return zero
+	}
+	return q.List.Head.Value
+}
+
+// Len :: func :: Returns the number of values in the Queue
+func (q *Queue[T]) Len() int {
+	if q.ring != nil {
+		return q.ring.Len()
+	}
+	return q.List.Len()
+}
+
+// IsEmpty :: func :: Reports whether the Queue holds no values
+func (q *Queue[T]) IsEmpty() bool {
+	if q.ring != nil {
+		return q.ring.Len() == 0
+	}
+	return q.List.IsEmpty()
+}
+
+// Option :: func :: Configures a Queue created with NewWith or NewFuncWith
+type Option func(*config)
+
+type config struct {
+	ring     bool
+	capacity int
+}
+
+// WithRingBuffer :: func :: Keeps the Queue's values in a RingBuffer rather than a DoublyLinkedList,
+// with room for capacity of them before it first grows
+func WithRingBuffer(capacity int) Option {
+	return func(c *config) {
+		c.ring = true
+		c.capacity = capacity
+	}
 }
@@ -2,7 +2,6 @@ package queue
 
 import (
 	"go-datastructures/linkedlist"
-	"go-datastructures/model"
 	"reflect"
 	"testing"
 )
@@ -12,7 +11,7 @@ func TestQueue_Dequeue(t *testing.T) {
 		name     string
 		values   []string
 		expected []string
-		want     model.Object
+		want     string
 		wantErr  bool
 	}{
 		{
@@ -26,23 +25,20 @@ func TestQueue_Dequeue(t *testing.T) {
 				"second",
 				"last",
 			},
-			want: model.Object{Value: "first"},
+			want: "first",
 		},
 		{
 			name: "first value is returned and queue is then empty",
 			values: []string{
 				"first",
 			},
-			want: model.Object{Value: "first"},
+			want: "first",
 		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			l := &linkedlist.DoublyLinkedList{}
-			l.AddNode(linkedlist.BuildDoubleNodes(tt.values)...)
-			expected := &linkedlist.DoublyLinkedList{}
-			expected.AddNode(linkedlist.BuildDoubleNodes(tt.expected)...)
-			q := &Queue{
+			l := New(tt.values...).List
+			q := &Queue[string]{
 				List: l,
 			}
 			got, err := q.Dequeue()
@@ -53,7 +49,7 @@ func TestQueue_Dequeue(t *testing.T) {
 			if !reflect.DeepEqual(got, tt.want) {
 				t.Errorf("Queue.Dequeue() = %v, want %v", got, tt.want)
 			}
-			if tt.want.Value != "" {
+			if tt.want != "" {
 				_, found := q.List.Find(tt.want)
 				if found {
 					t.Error("Stack.Pop() popped item not removed from stack")
@@ -65,7 +61,7 @@ func TestQueue_Dequeue(t *testing.T) {
 
 func TestQueue_Add(t *testing.T) {
 	type args struct {
-		obj model.Object
+		obj string
 	}
 	tests := []struct {
 		name     string
@@ -85,7 +81,7 @@ func TestQueue_Add(t *testing.T) {
 				"last",
 			},
 			args: args{
-				model.Object{Value: "last"},
+				"last",
 			},
 		},
 		{
@@ -94,17 +90,14 @@ func TestQueue_Add(t *testing.T) {
 				"first",
 			},
 			args: args{
-				model.Object{Value: "first"},
+				"first",
 			},
 		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			l := &linkedlist.DoublyLinkedList{}
-			l.AddNode(linkedlist.BuildDoubleNodes(tt.values)...)
-			expected := &linkedlist.DoublyLinkedList{}
-			expected.AddNode(linkedlist.BuildDoubleNodes(tt.expected)...)
-			q := &Queue{
+			l := New(tt.values...).List
+			q := &Queue[string]{
 				List: l,
 			}
 			q.Add(tt.args.obj)
@@ -113,10 +106,201 @@ func TestQueue_Add(t *testing.T) {
 			}
 			// Check that the links are intact
 			if q.List.Tail.Next != nil {
-				if !reflect.DeepEqual(q.List.Tail.Next.Value, model.Object{Value: tt.expected[len(tt.expected)-1]}) {
+				if !reflect.DeepEqual(q.List.Tail.Next.Value, tt.expected[len(tt.expected)-1]) {
 					t.Error("Add() did not add values as expected")
 				}
 			}
 		})
 	}
 }
+
+func TestQueue_NewFunc(t *testing.T) {
+	type job struct {
+		ID   int
+		Args []string
+	}
+	byID := func(a, b job) bool { return a.ID == b.ID }
+	q := NewFunc(byID, job{ID: 1, Args: []string{"a"}}, job{ID: 2})
+	q.Add(job{ID: 3})
+	if err := q.Remove(job{ID: 2}); err != nil {
+		t.Errorf("Queue.Remove() error = %v", err)
+	}
+	for _, want := range []int{1, 3} {
+		got, err := q.Dequeue()
+		if err != nil {
+			t.Fatalf("Queue.Dequeue() error = %v", err)
+		}
+		if got.ID != want {
+			t.Errorf("Queue.Dequeue() = %v, want ID %v", got, want)
+		}
+	}
+	if _, err := q.Dequeue(); err == nil {
+		t.Error("Queue.Dequeue() expected error on empty queue")
+	}
+}
+
+func TestQueue_Peek(t *testing.T) {
+	tests := []struct {
+		name   string
+		values []string
+		want   string
+	}{
+		{name: "empty queue", want: ""},
+		{name: "front of the queue", values: []string{"first", "second"}, want: "first"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			q := New(tt.values...)
+			if got := q.Peek(); got != tt.want {
+				t.Errorf("Queue.Peek() = %v, want %v", got, tt.want)
+			}
+			// Peek never changes what Dequeue returns
+			if got, _ := q.Dequeue(); got != tt.want {
+				t.Errorf("Queue.Dequeue() after Peek() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestQueue_Len(t *testing.T) {
+	q := New[string]()
+	if q.Len() != 0 || !q.IsEmpty() {
+		t.Errorf("Queue.Len() = %d, IsEmpty() = %v, want 0, true", q.Len(), q.IsEmpty())
+	}
+	q.Add("first")
+	q.Add("second")
+	if q.Len() != 2 || q.IsEmpty() {
+		t.Errorf("Queue.Len() = %d, IsEmpty() = %v, want 2, false", q.Len(), q.IsEmpty())
+	}
+	q.Remove("first")
+	q.Dequeue()
+	if q.Len() != 0 || !q.IsEmpty() {
+		t.Errorf("Queue.Len() = %d, IsEmpty() = %v after emptying it", q.Len(), q.IsEmpty())
+	}
+}
+
+// backends :: var :: a constructor for an empty Queue of each kind
+var backends = map[string]func() *Queue[string]{
+	"list": func() *Queue[string] { return NewWith[string]() },
+	"ring": func() *Queue[string] { return NewWith[string](WithRingBuffer(2)) },
+}
+
+func TestQueue_Backends(t *testing.T) {
+	for name, newQueue := range backends {
+		t.Run(name, func(t *testing.T) {
+			q := newQueue()
+			if q.List == nil {
+				t.Fatalf("List is nil on a %s Queue", name)
+			}
+			if _, err := q.Dequeue(); err == nil {
+				t.Error("Queue.Dequeue() expected error on empty queue")
+			}
+			for _, v := range []string{"a", "b", "c", "d", "e"} {
+				q.Add(v)
+			}
+			if err := q.Remove("c"); err != nil {
+				t.Errorf("Queue.Remove(c) error = %v", err)
+			}
+			if err := q.Remove("z"); err != linkedlist.ErrNotFound {
+				t.Errorf("Queue.Remove(z) error = %v, want %v", err, linkedlist.ErrNotFound)
+			}
+			if got := q.Peek(); got != "a" {
+				t.Errorf("Queue.Peek() = %v, want a", got)
+			}
+			if q.Len() != 4 {
+				t.Errorf("Queue.Len() = %d, want 4", q.Len())
+			}
+			for _, want := range []string{"a", "b", "d", "e"} {
+				if got, err := q.Dequeue(); got != want || err != nil {
+					t.Errorf("Queue.Dequeue() = %v, %v, want %v, nil", got, err, want)
+				}
+			}
+			if !q.IsEmpty() || q.Peek() != "" {
+				t.Errorf("Queue.IsEmpty() = %v, Peek() = %q after dequeuing everything", q.IsEmpty(), q.Peek())
+			}
+		})
+	}
+}
+
+// BenchmarkQueue :: func :: keeps a Queue at a steady length, adding one value for each dequeued
+func BenchmarkQueue(b *testing.B) {
+	for _, name := range []string{"list", "ring"} {
+		b.Run(name, func(b *testing.B) {
+			q := NewWith[int]()
+			if name == "ring" {
+				q = NewWith[int](WithRingBuffer(0))
+			}
+			for i := 0; i < 1024; i++ {
+				q.Add(i)
+			}
+			b.ReportAllocs()
+			b.ResetTimer()
+			for i := 0; i < b.N; i++ {
+				q.Add(i)
+				q.Dequeue()
+			}
+		})
+	}
+}
+
+func TestQueue_Duplicates(t *testing.T) {
+	// Values that compare equal are still dequeued in order, each exactly once
+	type job struct{ ID, Attempt int }
+	byID := func(a, b job) bool { return a.ID == b.ID }
+	for _, q := range []*Queue[job]{NewFunc(byID), NewFuncWith(byID, WithRingBuffer(0))} {
+		for attempt := range 3 {
+			q.Add(job{ID: 1, Attempt: attempt})
+		}
+		for _, want := range []int{0, 1, 2} {
+			if got, err := q.Dequeue(); got.Attempt != want || err != nil {
+				t.Errorf("Queue.Dequeue() = %v, %v, want attempt %d", got, err, want)
+			}
+		}
+		if !q.IsEmpty() {
+			t.Errorf("Queue.Len() = %d after dequeuing everything", q.Len())
+		}
+	}
+}
+
+func TestQueue_AddToEmpty(t *testing.T) {
+	// The first value added to an empty Queue is both the front and the back of its List
+	q := New[string]()
+	q.Add("first")
+	if q.List.Head == nil || q.List.Head != q.List.Tail {
+		t.Fatalf("Queue.Add() on an empty Queue left Head = %v, Tail = %v", q.List.Head, q.List.Tail)
+	}
+	if got := q.Peek(); got != "first" {
+		t.Errorf("Queue.Peek() = %v, want first", got)
+	}
+	q.Add("second")
+	for _, want := range []string{"first", "second"} {
+		if got, err := q.Dequeue(); got != want || err != nil {
+			t.Errorf("Queue.Dequeue() = %v, %v, want %v, nil", got, err, want)
+		}
+	}
+}
+
+func TestQueue_NilEqual(t *testing.T) {
+	// A nil equal falls back to == whichever constructor and backend it's given to
+	constructors := map[string]func() *Queue[string]{
+		"NewFunc":                     func() *Queue[string] { return NewFunc[string](nil) },
+		"NewFuncWith":                 func() *Queue[string] { return NewFuncWith[string](nil) },
+		"NewFuncWith(WithRingBuffer)": func() *Queue[string] { return NewFuncWith[string](nil, WithRingBuffer(2)) },
+	}
+	for name, newQueue := range constructors {
+		t.Run(name, func(t *testing.T) {
+			q := newQueue()
+			q.Add("a")
+			q.Add("b")
+			if err := q.Remove("c"); err == nil {
+				t.Errorf("Queue.Remove(c) expected error, c was never added")
+			}
+			if err := q.Remove("a"); err != nil {
+				t.Errorf("Queue.Remove(a) error = %v", err)
+			}
+			if got := q.Len(); got != 1 {
+				t.Errorf("Queue.Len() = %d after Remove(a), want 1", got)
+			}
+		})
+	}
+}
@@ -0,0 +1,334 @@
+package redblack
+
+import (
+	"cmp"
+	"errors"
+	"fmt"
+)
+
+// RedBlack :: struct :: Self-balancing BST, implemented as a left-leaning red-black tree.
+// Every Node is coloured red or black: no path from the Root to a leaf passes two red Nodes
+// in a row, every such path passes the same number of black ones, and red Nodes only ever
+// hang to the left. That keeps the height within 2*log2(n), with fewer rotations per write than an AVL.
+type RedBlack[T any] struct {
+	Root    *Node[T]
+	compare func(a, b T) int
+}
+
+// New :: func :: Returns a pointer to a new RedBlack ordered by the natural ordering of T
+func New[T cmp.Ordered]() *RedBlack[T] {
+	return NewFunc(cmp.Compare[T])
+}
+
+// NewFunc :: func :: Returns a pointer to a new RedBlack ordered by compare, which
+// returns a negative number when a < b, zero when a == b and a positive number when a > b
+func NewFunc[T any](compare func(a, b T) int) *RedBlack[T] {
+	return &RedBlack[T]{
+		compare: compare,
+	}
+}
+
+// Add :: func :: Adds a value to the RedBlack, recolouring and rotating along the insertion
+// path to keep it balanced. Adding a value that compares equal to one already in the tree
+// replaces the stored value.
+func (r *RedBlack[T]) Add(t T) {
+	r.Root = r.Root.add(t, r.comparator())
+	r.Root.Red = false
+}
+
+// Remove :: func :: Removes a object/value from the RedBlack. Returns an error if the value is not in the RedBlack.
+func (r *RedBlack[T]) Remove(obj T) (bool, error) {
+	// The way down borrows red links from siblings on the assumption that obj is there to remove
+	if _, found := r.Find(obj); !found {
+		return false, errors.New("object not found in list")
+	}
+	// Let the Root take part in the borrowing when neither child has a red link to give
+	if !r.Root.Left.isRed() && !r.Root.Right.isRed() {
+		r.Root.Red = true
+	}
+	r.Root = r.Root.remove(obj, r.comparator())
+	if r.Root != nil {
+		r.Root.Red = false
+	}
+	return true, nil
+}
+
+// Find :: func :: Returns the Node holding a value equal to obj
+func (r *RedBlack[T]) Find(obj T) (*Node[T], bool) {
+	return r.Root.find(obj, r.comparator())
+}
+
+// Size :: func :: Returns the number of values in the RedBlack
+func (r *RedBlack[T]) Size() int {
+	return r.Root.size()
+}
+
+// Height :: func :: Returns the number of Nodes on the longest path from the Root to a leaf
+func (r *RedBlack[T]) Height() int {
+	return r.Root.height()
+}
+
+// Validate :: func :: Checks the red-black invariants, returning an error describing the
+// first violation found: values must be in order, the Root must be black, red Nodes must be
+// left children of black ones, every path down must pass the same number of black Nodes,
+// and stored Sizes must be accurate.
+func (r *RedBlack[T]) Validate() error {
+	if r.Root.isRed() {
+		return fmt.Errorf("root %v is red", r.Root.Value)
+	}
+	_, _, err := r.Root.validate(r.comparator(), nil, nil)
+	return err
+}
+
+// comparator :: func :: A zero-value RedBlack has no ordering to fall back on, so fail loudly
+func (r *RedBlack[T]) comparator() func(x, y T) int {
+	if r.compare == nil {
+		panic("redblack: RedBlack has no comparator, create it with New or NewFunc")
+	}
+	return r.compare
+}
+
+// Node :: struct :: Node holds the values for the elements of the RedBlack, and any pointers to child values.
+// Red is the colour of the link from the Node's parent down to it; nil children count as black.
+// Size is the number of Nodes in the subtree rooted here, itself included.
+type Node[T any] struct {
+	Value T
+	Red   bool
+	Size  int
+	Left  *Node[T]
+	Right *Node[T]
+}
+
+func (n *Node[T]) find(t T, compare func(a, b T) int) (*Node[T], bool) {
+	for n != nil {
+		switch c := compare(t, n.Value); {
+		case c < 0:
+			n = n.Left
+		case c > 0:
+			n = n.Right
+		default:
+			return n, true
+		}
+	}
+	return nil, false
+}
+
+// add :: func :: adds a new red node below n, returning the root of the rebalanced subtree
+func (n *Node[T]) add(t T, compare func(a, b T) int) *Node[T] {
+	if n == nil {
+		return &Node[T]{Value: t, Red: true, Size: 1}
+	}
+	switch c := compare(t, n.Value); {
+	case c < 0:
+		n.Left = n.Left.add(t, compare)
+	case c > 0:
+		n.Right = n.Right.add(t, compare)
+	default:
+		n.Value = t
+		return n
+	}
+	return n.balance()
+}
+
+// remove :: func :: removes the node matching t, which must be present, from below n and
+// returns the root of the rebalanced subtree. On the way down it makes sure the Node it's
+// about to step into is red, or has a red left child, so that taking a Node off the bottom
+// never leaves a path short of a black Node.
+func (n *Node[T]) remove(t T, compare func(a, b T) int) *Node[T] {
+	if compare(t, n.Value) < 0 {
+		if !n.Left.isRed() && !n.Left.Left.isRed() {
+			n = n.moveRedLeft()
+		}
+		n.Left = n.Left.remove(t, compare)
+		return n.balance()
+	}
+	if n.Left.isRed() {
+		n = n.rotateRight()
+	}
+	if compare(t, n.Value) == 0 && n.Right == nil {
+		// A bottom Node with no left child either, or it'd have been rotated up above
+		return nil
+	}
+	if !n.Right.isRed() && !n.Right.Left.isRed() {
+		n = n.moveRedRight()
+	}
+	if compare(t, n.Value) == 0 {
+		// Take over the in-order successor's value, then remove the successor
+		n.Value = n.Right.min().Value
+		n.Right = n.Right.removeMin()
+	} else {
+		n.Right = n.Right.remove(t, compare)
+	}
+	return n.balance()
+}
+
+// removeMin :: func :: removes the left-most node below n, returning the root of the rebalanced subtree
+func (n *Node[T]) removeMin() *Node[T] {
+	if n.Left == nil {
+		return nil
+	}
+	if !n.Left.isRed() && !n.Left.Left.isRed() {
+		n = n.moveRedLeft()
+	}
+	n.Left = n.Left.removeMin()
+	return n.balance()
+}
+
+// min :: func :: returns the left-most node below n
+func (n *Node[T]) min() *Node[T] {
+	for n.Left != nil {
+		n = n.Left
+	}
+	return n
+}
+
+// max :: func :: returns the right-most node below n
+func (n *Node[T]) max() *Node[T] {
+	for n.Right != nil {
+		n = n.Right
+	}
+	return n
+}
+
+// balance :: func :: restores the left-leaning invariants at n on the way back up, and its Size,
+// returning the subtree's new root
+func (n *Node[T]) balance() *Node[T] {
+	if n.Right.isRed() && !n.Left.isRed() {
+		n = n.rotateLeft()
+	}
+	if n.Left.isRed() && n.Left.Left.isRed() {
+		n = n.rotateRight()
+	}
+	if n.Left.isRed() && n.Right.isRed() {
+		n.flipColors()
+	}
+	n.updateSize()
+	return n
+}
+
+// rotateLeft :: func :: turns a right-leaning red link into a left-leaning one
+//
+//	  n              r
+//	 / \            / \
+//	a   r    =>    n   c
+//	   / \        / \
+//	  b   c      a   b
+func (n *Node[T]) rotateLeft() *Node[T] {
+	r := n.Right
+	n.Right = r.Left
+	r.Left = n
+	r.Red = n.Red
+	n.Red = true
+	r.Size = n.Size
+	n.updateSize()
+	return r
+}
+
+// rotateRight :: func :: turns a left-leaning red link into a right-leaning one
+//
+//	    n          l
+//	   / \        / \
+//	  l   c  =>  a   n
+//	 / \            / \
+//	a   b          b   c
+func (n *Node[T]) rotateRight() *Node[T] {
+	l := n.Left
+	n.Left = l.Right
+	l.Right = n
+	l.Red = n.Red
+	n.Red = true
+	l.Size = n.Size
+	n.updateSize()
+	return l
+}
+
+// flipColors :: func :: swaps the colour of n with that of both its children, splitting
+// or merging the equivalent 2-3 tree node
+func (n *Node[T]) flipColors() {
+	n.Red = !n.Red
+	n.Left.Red = !n.Left.Red
+	n.Right.Red = !n.Right.Red
+}
+
+// moveRedLeft :: func :: makes n.Left or one of its children red, borrowing from n.Right if it can
+func (n *Node[T]) moveRedLeft() *Node[T] {
+	n.flipColors()
+	if n.Right.Left.isRed() {
+		n.Right = n.Right.rotateRight()
+		n = n.rotateLeft()
+		n.flipColors()
+	}
+	return n
+}
+
+// moveRedRight :: func :: makes n.Right or one of its children red, borrowing from n.Left if it can
+func (n *Node[T]) moveRedRight() *Node[T] {
+	n.flipColors()
+	if n.Left.Left.isRed() {
+		n = n.rotateRight()
+		n.flipColors()
+	}
+	return n
+}
+
+// isRed :: func :: nil-safe Red, an empty subtree is black
+func (n *Node[T]) isRed() bool {
+	return n != nil && n.Red
+}
+
+// size :: func :: nil-safe Size, an empty subtree has a size of 0
+func (n *Node[T]) size() int {
+	if n == nil {
+		return 0
+	}
+	return n.Size
+}
+
+// updateSize :: func :: recomputes n's Size from its children's
+func (n *Node[T]) updateSize() {
+	n.Size = 1 + n.Left.size() + n.Right.size()
+}
+
+// height :: func :: an empty subtree has a height of 0
+func (n *Node[T]) height() int {
+	if n == nil {
+		return 0
+	}
+	return 1 + max(n.Left.height(), n.Right.height())
+}
+
+// validate :: func :: checks the subtree rooted at n, whose values must fall strictly
+// between lo and hi when they're set, and returns the number of black Nodes on every path down
+// along with its actual size
+func (n *Node[T]) validate(compare func(a, b T) int, lo, hi *T) (int, int, error) {
+	if n == nil {
+		return 0, 0, nil
+	}
+	if (lo != nil && compare(n.Value, *lo) <= 0) || (hi != nil && compare(n.Value, *hi) >= 0) {
+		return 0, 0, fmt.Errorf("node %v is out of order", n.Value)
+	}
+	if n.Right.isRed() {
+		return 0, 0, fmt.Errorf("node %v has a red right child %v", n.Value, n.Right.Value)
+	}
+	if n.Red && n.Left.isRed() {
+		return 0, 0, fmt.Errorf("red node %v has a red child %v", n.Value, n.Left.Value)
+	}
+	lb, ls, err := n.Left.validate(compare, lo, &n.Value)
+	if err != nil {
+		return 0, 0, err
+	}
+	rb, rs, err := n.Right.validate(compare, &n.Value, hi)
+	if err != nil {
+		return 0, 0, err
+	}
+	if lb != rb {
+		return 0, 0, fmt.Errorf("node %v has %d black nodes down the left and %d down the right", n.Value, lb, rb)
+	}
+	if size := 1 + ls + rs; n.Size != size {
+		return 0, 0, fmt.Errorf("node %v has size %d, want %d", n.Value, n.Size, size)
+	}
+	if !n.Red {
+		lb++
+	}
+	return lb, n.Size, nil
+}
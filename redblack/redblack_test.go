@@ -0,0 +1,198 @@
+package redblack
+
+import (
+	"go-datastructures/model"
+	"go-datastructures/treetest"
+	"math"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestRedBlack_Suite(t *testing.T) {
+	treetest.Run(t, New[int], func(tree *RedBlack[int], v int) bool {
+		_, found := tree.Find(v)
+		return found
+	})
+}
+
+func TestRedBlack_Height(t *testing.T) {
+	tests := []struct {
+		name   string
+		values func(i int) int
+	}{
+		{name: "ascending", values: func(i int) int { return i }},
+		{name: "descending", values: func(i int) int { return -i }},
+		{name: "zig-zag", values: func(i int) int {
+			if i%2 == 0 {
+				return i
+			}
+			return -i
+		}},
+	}
+	const n = 4096
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := New[int]()
+			for i := 0; i < n; i++ {
+				r.Add(tt.values(i))
+			}
+			if err := r.Validate(); err != nil {
+				t.Fatalf("RedBlack.Validate() = %v", err)
+			}
+			if limit := int(2 * math.Log2(n+1)); r.Height() > limit {
+				t.Errorf("RedBlack.Height() = %d, want at most %d", r.Height(), limit)
+			}
+			for i := 0; i < n; i += 2 {
+				r.Remove(tt.values(i))
+			}
+			if err := r.Validate(); err != nil {
+				t.Fatalf("RedBlack.Validate() after Remove() = %v", err)
+			}
+			if limit := int(2 * math.Log2(n/2+1)); r.Height() > limit {
+				t.Errorf("RedBlack.Height() after Remove() = %d, want at most %d", r.Height(), limit)
+			}
+		})
+	}
+}
+
+func TestRedBlack_Validate(t *testing.T) {
+	tests := []struct {
+		name    string
+		root    *Node[int]
+		wantErr string
+	}{
+		{
+			name: "empty tree",
+		},
+		{
+			name: "valid tree",
+			root: &Node[int]{
+				Value: 2,
+				Size:  2,
+				Left:  &Node[int]{Value: 1, Red: true, Size: 1},
+			},
+		},
+		{
+			name:    "red root",
+			root:    &Node[int]{Value: 1, Red: true},
+			wantErr: "root 1 is red",
+		},
+		{
+			name: "out of order",
+			root: &Node[int]{
+				Value: 2,
+				Left:  &Node[int]{Value: 3},
+				Right: &Node[int]{Value: 4},
+			},
+			wantErr: "node 3 is out of order",
+		},
+		{
+			name: "red right child",
+			root: &Node[int]{
+				Value: 1,
+				Right: &Node[int]{Value: 2, Red: true},
+			},
+			wantErr: "node 1 has a red right child 2",
+		},
+		{
+			name: "two reds in a row",
+			root: &Node[int]{
+				Value: 3,
+				Left: &Node[int]{
+					Value: 2,
+					Red:   true,
+					Left:  &Node[int]{Value: 1, Red: true},
+				},
+				Right: &Node[int]{Value: 4},
+			},
+			wantErr: "red node 2 has a red child 1",
+		},
+		{
+			name: "uneven black height",
+			root: &Node[int]{
+				Value: 2,
+				Size:  2,
+				Left:  &Node[int]{Value: 1, Size: 1},
+			},
+			wantErr: "node 2 has 1 black nodes down the left and 0 down the right",
+		},
+		{
+			name: "stale Size",
+			root: &Node[int]{
+				Value: 2,
+				Size:  3,
+				Left:  &Node[int]{Value: 1, Red: true, Size: 1},
+			},
+			wantErr: "node 2 has size 3, want 2",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := New[int]()
+			r.Root = tt.root
+			err := r.Validate()
+			if (err != nil) != (tt.wantErr != "") || (err != nil && !strings.Contains(err.Error(), tt.wantErr)) {
+				t.Errorf("RedBlack.Validate() = %v, want %q", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestRedBlack_Size_HandBuilt(t *testing.T) {
+	// A tree built by setting Root, with each Node's Size filled in
+	r := New[int]()
+	r.Root = &Node[int]{
+		Value: 2,
+		Size:  3,
+		Left:  &Node[int]{Value: 1, Size: 1},
+		Right: &Node[int]{Value: 3, Size: 1},
+	}
+	steps := []struct {
+		name string
+		op   func()
+		want int
+	}{
+		{name: "built by hand", op: func() {}, want: 3},
+		{name: "Remove", op: func() { r.Remove(1) }, want: 2},
+		{name: "Remove missing value", op: func() { r.Remove(9) }, want: 2},
+		{name: "Add", op: func() { r.Add(4) }, want: 3},
+		{name: "Root replaced by hand", op: func() { r.Root = &Node[int]{Value: 7, Size: 1} }, want: 1},
+		{name: "emptied", op: func() { r.Remove(7) }, want: 0},
+	}
+	for _, s := range steps {
+		s.op()
+		if got := r.Size(); got != s.want {
+			t.Errorf("after %s RedBlack.Size() = %d, want %d", s.name, got, s.want)
+		}
+		if err := r.Validate(); err != nil {
+			t.Errorf("after %s RedBlack.Validate() = %v", s.name, err)
+		}
+	}
+}
+
+func TestRedBlack_Comparator(t *testing.T) {
+	t.Run("custom ordering", func(t *testing.T) {
+		r := NewFunc(treetest.CompareObjects)
+		for _, v := range []string{"ccc", "a", "bb", "b", "aaa"} {
+			r.Add(model.Object{Value: v})
+		}
+		var got []string
+		r.InOrder(func(o model.Object) bool {
+			got = append(got, o.Value)
+			return true
+		})
+		if want := []string{"a", "b", "bb", "aaa", "ccc"}; !reflect.DeepEqual(got, want) {
+			t.Errorf("RedBlack.InOrder() = %v, want %v", got, want)
+		}
+	})
+	t.Run("zero value panics", func(t *testing.T) {
+		defer func() {
+			if recover() == nil {
+				t.Errorf("RedBlack.Add() on a zero value RedBlack did not panic")
+			}
+		}()
+		var r RedBlack[int]
+		r.Add(1)
+	})
+}
@@ -0,0 +1,42 @@
+package redblack
+
+// The rest of the orderedset.OrderedSet API; Add and Remove live with the rest of the RedBlack.
+
+// Contains :: func :: Reports whether a value equal to t is in the RedBlack
+func (r *RedBlack[T]) Contains(t T) bool {
+	_, found := r.Find(t)
+	return found
+}
+
+// Len :: func :: Returns the number of values in the RedBlack, the same as Size
+func (r *RedBlack[T]) Len() int {
+	return r.Size()
+}
+
+// Min :: func :: Returns the smallest value in the RedBlack, false if it's empty
+func (r *RedBlack[T]) Min() (T, bool) {
+	if r.Root == nil {
+		var zero T
+		return zero, false
+	}
+	return r.Root.min().Value, true
+}
+
+// Max :: func :: Returns the largest value in the RedBlack, false if it's empty
+func (r *RedBlack[T]) Max() (T, bool) {
+	if r.Root == nil {
+		var zero T
+		return zero, false
+	}
+	return r.Root.max().Value, true
+}
+
+// Ascend :: func :: Calls f with each value in Sort Order until f returns false, the same as InOrder
+func (r *RedBlack[T]) Ascend(f NodeFunc[T]) {
+	r.InOrder(f)
+}
+
+// Descend :: func :: Calls f with each value in reverse Sort Order until f returns false
+func (r *RedBlack[T]) Descend(f NodeFunc[T]) {
+	walk[T]().InOrder(r.Root, f, true)
+}
@@ -0,0 +1,15 @@
+package redblack
+
+import (
+	"go-datastructures/orderedset"
+	"go-datastructures/treetest"
+	"testing"
+)
+
+var _ orderedset.OrderedSet[int] = (*RedBlack[int])(nil)
+
+func TestRedBlack_OrderedSet(t *testing.T) {
+	treetest.RunSet(t, func() orderedset.OrderedSet[int] {
+		return New[int]()
+	})
+}
@@ -0,0 +1,61 @@
+package redblack
+
+import (
+	"go-datastructures/treewalk"
+	"iter"
+)
+
+// Traversals are the shared, non-recursive ones from treewalk.
+// Every traversal takes a NodeFunc, which has the same shape as an iter.Seq yield function:
+// the methods can be called directly with a callback, or ranged over.
+//
+//	for v := range tree.PreOrder {
+//		...
+//	}
+
+// NodeFunc :: func :: Some function that takes in a stored value and does an operation on it.
+// Returning false stops the traversal.
+type NodeFunc[T any] = func(t T) bool
+
+// PreOrder :: func :: Processes current, left, right
+func (r *RedBlack[T]) PreOrder(f NodeFunc[T]) {
+	walk[T]().PreOrder(r.Root, f)
+}
+
+// InOrder :: func :: Processes left, current, right
+// Items in the list will be processed in Sort Order
+func (r *RedBlack[T]) InOrder(f NodeFunc[T]) {
+	walk[T]().InOrder(r.Root, f, false)
+}
+
+// PostOrder :: func :: Processes left, right, current
+// Root will be processed last -- Deletion of the entire tree could be a use case
+func (r *RedBlack[T]) PostOrder(f NodeFunc[T]) {
+	walk[T]().PostOrder(r.Root, f)
+}
+
+// LevelOrder :: func :: Processes the tree breadth-first, one level at a time from the Root down,
+// each level left to right
+func (r *RedBlack[T]) LevelOrder(f NodeFunc[T]) {
+	walk[T]().LevelOrder(r.Root, f)
+}
+
+// All :: func :: Returns an iterator over the values in Sort Order
+func (r *RedBlack[T]) All() iter.Seq[T] {
+	return r.InOrder
+}
+
+// Backward :: func :: Returns an iterator over the values in reverse Sort Order
+func (r *RedBlack[T]) Backward() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		walk[T]().InOrder(r.Root, yield, true)
+	}
+}
+
+// walk :: func :: how the treewalk traversals read a Node
+func walk[T any]() treewalk.Nodes[*Node[T], T] {
+	return treewalk.Nodes[*Node[T], T]{
+		Value:    func(n *Node[T]) T { return n.Value },
+		Children: func(n *Node[T]) (*Node[T], *Node[T]) { return n.Left, n.Right },
+	}
+}
@@ -0,0 +1,154 @@
+package ringbuffer
+
+const minCapacity = 8
+
+// RingBuffer :: struct :: Growable circular buffer, a double-ended queue held in a single slice.
+// Values sit in buf from head onwards, wrapping around to the start of it, so both ends can be
+// pushed to and popped from in O(1) without moving anything else. When buf fills up it's replaced
+// by one twice the size, with the values unwrapped into it.
+// The zero value is an empty RingBuffer ready to use.
+//
+//	buf:  [ d e _ _ _ a b c ]   head = 5, size = 5
+//	        ^         ^
+//	        back      front
+type RingBuffer[T any] struct {
+	// buf's length is always zero or a power of two, so an index wraps with & instead of %
+	buf  []T
+	head int
+	size int
+}
+
+// New :: func :: Returns a pointer to a new RingBuffer with room for at least capacity values before it grows
+func New[T any](capacity int) *RingBuffer[T] {
+	r := &RingBuffer[T]{}
+	if capacity > 0 {
+		r.resize(capacity)
+	}
+	return r
+}
+
+// PushBack :: func :: Adds a value at the back of the RingBuffer
+func (r *RingBuffer[T]) PushBack(obj T) {
+	r.grow()
+	r.buf[r.wrap(r.head+r.size)] = obj
+	r.size++
+}
+
+// PushFront :: func :: Adds a value at the front of the RingBuffer
+func (r *RingBuffer[T]) PushFront(obj T) {
+	r.grow()
+	r.head = r.wrap(r.head - 1)
+	r.buf[r.head] = obj
+	r.size++
+}
+
+// PopFront :: func :: Removes and returns the value at the front, false if the RingBuffer is empty
+func (r *RingBuffer[T]) PopFront() (T, bool) {
+	var zero T
+	if r.size == 0 {
+		return zero, false
+	}
+	obj := r.buf[r.head]
+	// Clear the slot so it doesn't keep the value alive
+	r.buf[r.head] = zero
+	r.head = r.wrap(r.head + 1)
+	r.size--
+	return obj, true
+}
+
+// PopBack :: func :: Removes and returns the value at the back, false if the RingBuffer is empty
+func (r *RingBuffer[T]) PopBack() (T, bool) {
+	var zero T
+	if r.size == 0 {
+		return zero, false
+	}
+	r.size--
+	i := r.wrap(r.head + r.size)
+	obj := r.buf[i]
+	r.buf[i] = zero
+	return obj, true
+}
+
+// Front :: func :: Returns the value at the front, false if the RingBuffer is empty
+func (r *RingBuffer[T]) Front() (T, bool) {
+	return r.At(0)
+}
+
+// Back :: func :: Returns the value at the back, false if the RingBuffer is empty
+func (r *RingBuffer[T]) Back() (T, bool) {
+	return r.At(r.size - 1)
+}
+
+// At :: func :: Returns the value i places from the front, false if i is outside the RingBuffer
+func (r *RingBuffer[T]) At(i int) (T, bool) {
+	if i < 0 || i >= r.size {
+		var zero T
+		return zero, false
+	}
+	return r.buf[r.wrap(r.head+i)], true
+}
+
+// RemoveAt :: func :: Removes the value i places from the front, returning it, or false if i is
+// outside the RingBuffer. Whichever side of i is shorter is shifted along to close the gap.
+func (r *RingBuffer[T]) RemoveAt(i int) (T, bool) {
+	obj, ok := r.At(i)
+	if !ok {
+		return obj, false
+	}
+	if i < r.size/2 {
+		// Shift the values in front of i back by one, and drop the front
+		for j := i; j > 0; j-- {
+			r.buf[r.wrap(r.head+j)] = r.buf[r.wrap(r.head+j-1)]
+		}
+		r.PopFront()
+	} else {
+		// Shift the values behind i forward by one, and drop the back
+		for j := i; j < r.size-1; j++ {
+			r.buf[r.wrap(r.head+j)] = r.buf[r.wrap(r.head+j+1)]
+		}
+		r.PopBack()
+	}
+	return obj, true
+}
+
+// Len :: func :: Returns the number of values in the RingBuffer
+func (r *RingBuffer[T]) Len() int {
+	return r.size
+}
+
+// Cap :: func :: Returns the number of values the RingBuffer can hold before it next grows
+func (r *RingBuffer[T]) Cap() int {
+	return len(r.buf)
+}
+
+// IsEmpty :: func :: Reports whether the RingBuffer holds no values
+func (r *RingBuffer[T]) IsEmpty() bool {
+	return r.size == 0
+}
+
+// wrap :: func :: maps an index that may have run off either end of buf back into it
+func (r *RingBuffer[T]) wrap(i int) int {
+	return i & (len(r.buf) - 1)
+}
+
+// grow :: func :: doubles buf if there's no room for another value
+func (r *RingBuffer[T]) grow() {
+	if r.size == len(r.buf) {
+		r.resize(2 * r.size)
+	}
+}
+
+// resize :: func :: moves the values into a new buf of at least capacity, unwrapped so head is 0
+func (r *RingBuffer[T]) resize(capacity int) {
+	n := minCapacity
+	for n < capacity {
+		n *= 2
+	}
+	buf := make([]T, n)
+	if r.size > 0 {
+		// The values run from head to the end of buf, then on from its start
+		copied := copy(buf, r.buf[r.head:min(r.head+r.size, len(r.buf))])
+		copy(buf[copied:], r.buf[:r.size-copied])
+	}
+	r.buf, r.head = buf, 0
+}
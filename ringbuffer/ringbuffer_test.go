@@ -0,0 +1,196 @@
+package ringbuffer
+
+import (
+	"math/rand"
+	"slices"
+	"testing"
+)
+
+// values :: func :: returns the RingBuffer's values from front to back
+func values[T any](r *RingBuffer[T]) []T {
+	var out []T
+	for i := 0; i < r.Len(); i++ {
+		v, _ := r.At(i)
+		out = append(out, v)
+	}
+	return out
+}
+
+func TestRingBuffer(t *testing.T) {
+	tests := []struct {
+		name string
+		ops  func(r *RingBuffer[int])
+		want []int
+	}{
+		{
+			name: "empty",
+			ops:  func(r *RingBuffer[int]) {},
+		},
+		{
+			name: "push back",
+			ops: func(r *RingBuffer[int]) {
+				r.PushBack(1)
+				r.PushBack(2)
+				r.PushBack(3)
+			},
+			want: []int{1, 2, 3},
+		},
+		{
+			name: "push front wraps around",
+			ops: func(r *RingBuffer[int]) {
+				r.PushFront(1)
+				r.PushFront(2)
+				r.PushBack(3)
+			},
+			want: []int{2, 1, 3},
+		},
+		{
+			name: "grows while wrapped",
+			ops: func(r *RingBuffer[int]) {
+				for i := 0; i < 6; i++ {
+					r.PushBack(i)
+				}
+				for i := 0; i < 4; i++ {
+					r.PopFront()
+				}
+				for i := 6; i < 20; i++ {
+					r.PushBack(i)
+				}
+			},
+			want: []int{4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16, 17, 18, 19},
+		},
+		{
+			name: "pops from both ends",
+			ops: func(r *RingBuffer[int]) {
+				for i := 0; i < 5; i++ {
+					r.PushBack(i)
+				}
+				r.PopFront()
+				r.PopBack()
+			},
+			want: []int{1, 2, 3},
+		},
+		{
+			name: "removes near the front",
+			ops: func(r *RingBuffer[int]) {
+				for i := 0; i < 6; i++ {
+					r.PushFront(i)
+				}
+				r.RemoveAt(1)
+			},
+			want: []int{5, 3, 2, 1, 0},
+		},
+		{
+			name: "removes near the back",
+			ops: func(r *RingBuffer[int]) {
+				for i := 0; i < 6; i++ {
+					r.PushFront(i)
+				}
+				r.RemoveAt(4)
+			},
+			want: []int{5, 4, 3, 2, 0},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var r RingBuffer[int]
+			tt.ops(&r)
+			if got := values(&r); !slices.Equal(got, tt.want) {
+				t.Errorf("RingBuffer holds %v, want %v", got, tt.want)
+			}
+			if r.Len() != len(tt.want) || r.IsEmpty() != (len(tt.want) == 0) {
+				t.Errorf("Len() = %d, IsEmpty() = %v with %d values", r.Len(), r.IsEmpty(), len(tt.want))
+			}
+		})
+	}
+}
+
+func TestRingBuffer_Empty(t *testing.T) {
+	var r RingBuffer[string]
+	for name, get := range map[string]func() (string, bool){
+		"PopFront": r.PopFront,
+		"PopBack":  r.PopBack,
+		"Front":    r.Front,
+		"Back":     r.Back,
+	} {
+		if got, ok := get(); got != "" || ok {
+			t.Errorf("%s() = %q, %v on an empty RingBuffer", name, got, ok)
+		}
+	}
+	r.PushBack("a")
+	for _, i := range []int{-1, 1} {
+		if _, ok := r.At(i); ok {
+			t.Errorf("At(%d) found a value outside the RingBuffer", i)
+		}
+		if _, ok := r.RemoveAt(i); ok {
+			t.Errorf("RemoveAt(%d) removed a value outside the RingBuffer", i)
+		}
+	}
+}
+
+func TestNew(t *testing.T) {
+	tests := []struct {
+		capacity int
+		want     int
+	}{
+		{capacity: 0, want: 0},
+		{capacity: 1, want: 8},
+		{capacity: 8, want: 8},
+		{capacity: 9, want: 16},
+		{capacity: 100, want: 128},
+	}
+	for _, tt := range tests {
+		if got := New[int](tt.capacity).Cap(); got != tt.want {
+			t.Errorf("New(%d).Cap() = %d, want %d", tt.capacity, got, tt.want)
+		}
+	}
+}
+
+func TestRingBuffer_Random(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+	var r RingBuffer[int]
+	var want []int
+	for i := 0; i < 5000; i++ {
+		switch op := rng.Intn(6); op {
+		case 0:
+			r.PushFront(i)
+			want = slices.Insert(want, 0, i)
+		case 1:
+			r.PushBack(i)
+			want = append(want, i)
+		case 2, 3:
+			pop := r.PopFront
+			if op == 3 {
+				pop = r.PopBack
+			}
+			got, ok := pop()
+			if ok != (len(want) > 0) {
+				t.Fatalf("operation %d popped %v, %v from %v", i, got, ok, want)
+			}
+			if !ok {
+				continue
+			}
+			wantVal := want[0]
+			if op == 3 {
+				wantVal, want = want[len(want)-1], want[:len(want)-1]
+			} else {
+				want = want[1:]
+			}
+			if got != wantVal {
+				t.Fatalf("operation %d popped %v, want %v", i, got, wantVal)
+			}
+		default:
+			if len(want) == 0 {
+				continue
+			}
+			j := rng.Intn(len(want))
+			if got, ok := r.RemoveAt(j); !ok || got != want[j] {
+				t.Fatalf("RemoveAt(%d) = %v, %v, want %v, true", j, got, ok, want[j])
+			}
+			want = slices.Delete(want, j, j+1)
+		}
+		if got := values(&r); !slices.Equal(got, want) {
+			t.Fatalf("after operation %d RingBuffer holds %v, want %v", i, got, want)
+		}
+	}
+}
@@ -3,33 +3,50 @@ package stack
 import (
 	"errors"
 	"go-datastructures/linkedlist"
-	"go-datastructures/model"
 )
 
 // Stack :: struct :: FIFO collection
-type Stack struct {
-	List *linkedlist.SinglyLinkedList
+type Stack[T any] struct {
+	List *linkedlist.SinglyLinkedList[T]
 }
 
 // New :: func :: Returns pointer to a new Stack
-func New(values ...string) *Stack {
+func New[T comparable](values ...T) *Stack[T] {
 	l := linkedlist.NewSinglyLinked(values...)
-	return &Stack{
+	return &Stack[T]{
+		List: l,
+	}
+}
+
+// NewFunc :: func :: Returns pointer to a new Stack whose values are compared with equal
+func NewFunc[T any](equal linkedlist.EqualFunc[T], values ...T) *Stack[T] {
+	l := linkedlist.NewSinglyLinkedFunc(equal, values...)
+	return &Stack[T]{
 		List: l,
 	}
 }
 
 // Pop :: func :: returns the first value in the Stack,
 // and removes that value from the embedded LinkedList
-func (s *Stack) Pop() (model.Object, error) {
+func (s *Stack[T]) Pop() (T, error) {
 	if s.List.Head != nil {
-		val := s.List.Head.Value
-		return val, s.List.Remove(val)
+		return s.List.RemoveHead()
 	}
-	return model.Object{}, errors.New("stack is empty")
+	var zero T
+	return zero, errors.New("stack is empty")
 }
 
 // Add :: func :: Adds a value to the Stack in first position
-func (s *Stack) Add(obj model.Object) {
+func (s *Stack[T]) Add(obj T) {
 	s.List.Add(obj)
 }
+
+// Len :: func :: Returns the number of values in the Stack
+func (s *Stack[T]) Len() int {
+	return s.List.Len()
+}
+
+// IsEmpty :: func :: Reports whether the Stack holds no values
+func (s *Stack[T]) IsEmpty() bool {
+	return s.List.IsEmpty()
+}
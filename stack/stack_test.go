@@ -2,7 +2,6 @@ package stack
 
 import (
 	"go-datastructures/linkedlist"
-	"go-datastructures/model"
 	"reflect"
 	"testing"
 )
@@ -12,7 +11,7 @@ func TestStack_Pop(t *testing.T) {
 		name     string
 		values   []string
 		expected []string
-		want     model.Object
+		want     string
 		wantErr  bool
 	}{
 		{
@@ -28,23 +27,20 @@ func TestStack_Pop(t *testing.T) {
 			expected: []string{
 				"second",
 			},
-			want: model.Object{Value: "first"},
+			want: "first",
 		},
 		{
 			name: "single item is popped",
 			values: []string{
 				"first",
 			},
-			want: model.Object{Value: "first"},
+			want: "first",
 		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			l := &linkedlist.SinglyLinkedList{}
-			l.AddNode(linkedlist.BuildSingleNodes(tt.values)...)
-			expected := &linkedlist.SinglyLinkedList{}
-			expected.AddNode(linkedlist.BuildSingleNodes(tt.expected)...)
-			s := &Stack{
+			l := New(tt.values...).List
+			s := &Stack[string]{
 				List: l,
 			}
 			got, err := s.Pop()
@@ -55,7 +51,7 @@ func TestStack_Pop(t *testing.T) {
 			if !reflect.DeepEqual(got, tt.want) {
 				t.Errorf("Stack.Pop() = %v, want %v", got, tt.want)
 			}
-			if tt.want.Value != "" {
+			if tt.want != "" {
 				_, found := s.List.Find(tt.want)
 				if found {
 					t.Error("Stack.Pop() popped item not removed from stack")
@@ -67,7 +63,7 @@ func TestStack_Pop(t *testing.T) {
 
 func TestStack_Add(t *testing.T) {
 	type args struct {
-		obj model.Object
+		obj string
 	}
 	tests := []struct {
 		name     string
@@ -87,7 +83,7 @@ func TestStack_Add(t *testing.T) {
 				"second",
 			},
 			args: args{
-				model.Object{Value: "newFirst"},
+				"newFirst",
 			},
 		},
 		{
@@ -96,17 +92,14 @@ func TestStack_Add(t *testing.T) {
 				"first",
 			},
 			args: args{
-				model.Object{Value: "first"},
+				"first",
 			},
 		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			l := &linkedlist.SinglyLinkedList{}
-			l.AddNode(linkedlist.BuildSingleNodes(tt.values)...)
-			expected := &linkedlist.SinglyLinkedList{}
-			expected.AddNode(linkedlist.BuildSingleNodes(tt.expected)...)
-			s := &Stack{
+			l := New(tt.values...).List
+			s := &Stack[string]{
 				List: l,
 			}
 			s.Add(tt.args.obj)
@@ -115,10 +108,61 @@ func TestStack_Add(t *testing.T) {
 			}
 			// Check that the links are intact
 			if s.List.Head.Next != nil {
-				if !reflect.DeepEqual(s.List.Head.Next.Value, model.Object{Value: tt.expected[1]}) {
+				if !reflect.DeepEqual(s.List.Head.Next.Value, tt.expected[1]) {
 					t.Error("Add() did not add values as expected")
 				}
 			}
 		})
 	}
 }
+
+func TestStack_Len(t *testing.T) {
+	s := New("first", "second")
+	if s.Len() != 2 || s.IsEmpty() {
+		t.Errorf("Stack.Len() = %d, IsEmpty() = %v, want 2, false", s.Len(), s.IsEmpty())
+	}
+	s.Add("third")
+	if s.Len() != 3 {
+		t.Errorf("Stack.Len() after Add() = %d, want 3", s.Len())
+	}
+	for i := 2; i >= 0; i-- {
+		s.Pop()
+		if s.Len() != i {
+			t.Errorf("Stack.Len() after Pop() = %d, want %d", s.Len(), i)
+		}
+	}
+	s.Pop()
+	if s.Len() != 0 || !s.IsEmpty() {
+		t.Errorf("Stack.Len() = %d, IsEmpty() = %v after popping an empty Stack", s.Len(), s.IsEmpty())
+	}
+}
+
+func TestStack_HandBuilt(t *testing.T) {
+	s := &Stack[int]{List: &linkedlist.SinglyLinkedList[int]{Head: &linkedlist.Node[int]{Value: 7}}}
+	if s.IsEmpty() {
+		t.Errorf("Stack.IsEmpty() = true with a value linked onto its Head")
+	}
+	if got, err := s.Pop(); got != 7 || err != nil {
+		t.Errorf("Stack.Pop() = %v, %v, want 7, nil", got, err)
+	}
+	if s.Len() != 0 || !s.IsEmpty() {
+		t.Errorf("Stack.Len() = %d, IsEmpty() = %v after popping its only value", s.Len(), s.IsEmpty())
+	}
+}
+
+func TestStack_Duplicates(t *testing.T) {
+	// Values that compare equal are still popped in order, each exactly once
+	type job struct{ ID, Attempt int }
+	s := NewFunc(func(a, b job) bool { return a.ID == b.ID })
+	for attempt := range 3 {
+		s.Add(job{ID: 1, Attempt: attempt})
+	}
+	for _, want := range []int{2, 1, 0} {
+		if got, err := s.Pop(); got.Attempt != want || err != nil {
+			t.Errorf("Stack.Pop() = %v, %v, want attempt %d", got, err, want)
+		}
+	}
+	if _, err := s.Pop(); err == nil || !s.IsEmpty() {
+		t.Errorf("Stack.Pop() error = %v, IsEmpty() = %v after popping everything", err, s.IsEmpty())
+	}
+}
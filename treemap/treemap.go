@@ -0,0 +1,82 @@
+package treemap
+
+// TreeMap :: struct :: Ordered map from keys to values, kept in a tree of Entries ordered by Key.
+// bst.TreeMap and avl.TreeMap are this type, each backed by its own package's tree.
+type TreeMap[K, V any] struct {
+	tree Tree[Entry[K, V]]
+	len  int
+}
+
+// Entry :: struct :: Key/value pair stored in a TreeMap
+type Entry[K, V any] struct {
+	Key   K
+	Value V
+}
+
+// Tree :: struct :: The tree operations a TreeMap is built on. Each package's tree has its own
+// Node type, so like treewalk.Nodes they're supplied as funcs. Add must replace a stored value
+// that compares equal, and Find returns the stored value comparing equal to t.
+type Tree[T any] struct {
+	Add     func(t T)
+	Remove  func(t T) bool
+	Find    func(t T) (T, bool)
+	InOrder func(f func(t T) bool)
+}
+
+// New :: func :: Returns a pointer to a new TreeMap keeping its Entries in tree, which must be
+// empty and ordered by ByKey
+func New[K, V any](tree Tree[Entry[K, V]]) *TreeMap[K, V] {
+	return &TreeMap[K, V]{tree: tree}
+}
+
+// ByKey :: func :: Returns an ordering of Entries that compares their Keys with compare
+func ByKey[K, V any](compare func(a, b K) int) func(a, b Entry[K, V]) int {
+	return func(a, b Entry[K, V]) int {
+		return compare(a.Key, b.Key)
+	}
+}
+
+// Put :: func :: Stores value under key, replacing any entry whose key compares equal
+func (m *TreeMap[K, V]) Put(key K, value V) {
+	if !m.ContainsKey(key) {
+		m.len++
+	}
+	m.tree.Add(Entry[K, V]{Key: key, Value: value})
+}
+
+// Get :: func :: Returns the value stored under key, and whether there was one
+func (m *TreeMap[K, V]) Get(key K) (V, bool) {
+	if e, found := m.tree.Find(Entry[K, V]{Key: key}); found {
+		return e.Value, true
+	}
+	var zero V
+	return zero, false
+}
+
+// Delete :: func :: Removes key from the TreeMap, returning false if it wasn't present
+func (m *TreeMap[K, V]) Delete(key K) bool {
+	removed := m.tree.Remove(Entry[K, V]{Key: key})
+	if removed {
+		m.len--
+	}
+	return removed
+}
+
+// ContainsKey :: func :: Reports whether a value is stored under key
+func (m *TreeMap[K, V]) ContainsKey(key K) bool {
+	_, found := m.tree.Find(Entry[K, V]{Key: key})
+	return found
+}
+
+// Len :: func :: Returns the number of entries in the TreeMap
+func (m *TreeMap[K, V]) Len() int {
+	return m.len
+}
+
+// InOrder :: func :: Calls f for each entry in key order, until f returns false.
+// Like the tree traversals it can be ranged over directly, as an iter.Seq2.
+func (m *TreeMap[K, V]) InOrder(f func(key K, value V) bool) {
+	m.tree.InOrder(func(e Entry[K, V]) bool {
+		return f(e.Key, e.Value)
+	})
+}
@@ -0,0 +1,19 @@
+package treetest
+
+import (
+	"go-datastructures/model"
+	"strings"
+)
+
+// CompareObjects :: func :: Orders model.Objects by the length of their Value, which is how the trees
+// originally placed them. Values of the same length fall back to a lexical comparison
+// so that the ordering is total.
+func CompareObjects(o1, o2 model.Object) int {
+	if len(o1.Value) != len(o2.Value) {
+		if len(o1.Value) < len(o2.Value) {
+			return -1
+		}
+		return 1
+	}
+	return strings.Compare(o1.Value, o2.Value)
+}
@@ -0,0 +1,138 @@
+package treetest
+
+import (
+	"go-datastructures/orderedset"
+	"math/rand"
+	"slices"
+	"testing"
+)
+
+// Conformance tests for orderedset.OrderedSet. A new implementation plugs in with
+//
+//	func TestMySet_OrderedSet(t *testing.T) {
+//		treetest.RunSet(t, func() orderedset.OrderedSet[int] { return NewMySet[int]() })
+//	}
+
+// RunSet :: func :: Checks that the sets made by newSet behave as an orderedset.OrderedSet should.
+// Every subtest starts from a fresh, empty set.
+func RunSet(t *testing.T, newSet func() orderedset.OrderedSet[int]) {
+	t.Run("Add", func(t *testing.T) {
+		for _, tt := range addTests {
+			t.Run(tt.name, func(t *testing.T) {
+				s := newSet()
+				for _, v := range tt.values {
+					s.Add(v)
+				}
+				checkSet(t, s, tt.want)
+			})
+		}
+	})
+
+	t.Run("Remove", func(t *testing.T) {
+		for _, tt := range removeTests {
+			t.Run(tt.name, func(t *testing.T) {
+				s := newSet()
+				for _, v := range tt.values {
+					s.Add(v)
+				}
+				removed, err := s.Remove(tt.remove)
+				if (err != nil) != tt.wantErr || removed == tt.wantErr {
+					t.Errorf("Remove(%d) = %v, %v, wantErr %v", tt.remove, removed, err, tt.wantErr)
+				}
+				checkSet(t, s, tt.want)
+			})
+		}
+	})
+
+	t.Run("early exit", func(t *testing.T) {
+		s := newSet()
+		for _, v := range sequence(1, 10) {
+			s.Add(v)
+		}
+		for name, walk := range map[string]func(f func(int) bool){"Ascend": s.Ascend, "Descend": s.Descend} {
+			calls := 0
+			walk(func(int) bool {
+				calls++
+				return calls < 3
+			})
+			if calls != 3 {
+				t.Errorf("%s() called f %d times after it returned false on the 3rd", name, calls)
+			}
+		}
+	})
+
+	t.Run("random operations", func(t *testing.T) {
+		rng := rand.New(rand.NewSource(1))
+		s := newSet()
+		members := map[int]bool{}
+		for i := 0; i < 5000; i++ {
+			v := rng.Intn(500)
+			switch rng.Intn(3) {
+			case 0:
+				_, err := s.Remove(v)
+				if (err == nil) != members[v] {
+					t.Fatalf("Remove(%d) error = %v, but member = %v", v, err, members[v])
+				}
+				delete(members, v)
+			case 1:
+				if got := s.Contains(v); got != members[v] {
+					t.Fatalf("Contains(%d) = %v, want %v", v, got, members[v])
+				}
+			default:
+				s.Add(v)
+				members[v] = true
+			}
+			if s.Len() != len(members) {
+				t.Fatalf("Len() = %d after operation %d, want %d", s.Len(), i, len(members))
+			}
+		}
+		var want []int
+		for v := range members {
+			want = append(want, v)
+		}
+		slices.Sort(want)
+		checkSet(t, s, want)
+	})
+}
+
+// checkSet :: func :: compares everything the set reports about itself with want, which is sorted
+func checkSet(t *testing.T, s orderedset.OrderedSet[int], want []int) {
+	t.Helper()
+	if got := s.Len(); got != len(want) {
+		t.Errorf("Len() = %d, want %d", got, len(want))
+	}
+	if got := collect(s.Ascend); !slices.Equal(got, want) {
+		t.Errorf("Ascend() = %v, want %v", got, want)
+	}
+	backward := slices.Clone(want)
+	slices.Reverse(backward)
+	if got := collect(s.Descend); !slices.Equal(got, backward) {
+		t.Errorf("Descend() = %v, want %v", got, backward)
+	}
+	for _, v := range want {
+		if !s.Contains(v) {
+			t.Errorf("Contains(%d) = false for a member", v)
+		}
+	}
+	outside := 0
+	if len(want) > 0 {
+		outside = want[len(want)-1] + 1
+	}
+	if s.Contains(outside) {
+		t.Errorf("Contains(%d) = true for a value that isn't a member", outside)
+	}
+	lo, loFound := s.Min()
+	hi, hiFound := s.Max()
+	if len(want) == 0 {
+		if loFound || hiFound {
+			t.Errorf("Min(), Max() found %v, %v in an empty set", lo, hi)
+		}
+		return
+	}
+	if lo != want[0] || !loFound {
+		t.Errorf("Min() = %v, %v, want %v, true", lo, loFound, want[0])
+	}
+	if hi != want[len(want)-1] || !hiFound {
+		t.Errorf("Max() = %v, %v, want %v, true", hi, hiFound, want[len(want)-1])
+	}
+}
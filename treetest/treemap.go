@@ -0,0 +1,127 @@
+package treetest
+
+import (
+	"go-datastructures/treemap"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+// Payload :: struct :: The values RunTreeMap stores
+type Payload struct {
+	Owner string
+	Size  int
+}
+
+// RunTreeMap :: func :: Runs the shared TreeMap tests against maps made by newMap, ordered by
+// the natural ordering of their keys, and by newMapFunc, ordered by compare
+func RunTreeMap(t *testing.T, newMap func() *treemap.TreeMap[string, Payload],
+	newMapFunc func(compare func(a, b string) int) *treemap.TreeMap[string, Payload]) {
+	type put struct {
+		key   string
+		value Payload
+	}
+	tests := []struct {
+		name    string
+		tree    *treemap.TreeMap[string, Payload]
+		puts    []put
+		deletes []string
+		want    []treemap.Entry[string, Payload]
+	}{
+		{
+			name: "empty map",
+			tree: newMap(),
+		},
+		{
+			name: "entries come back in key order",
+			tree: newMap(),
+			puts: []put{
+				{"m", Payload{"mallory", 3}},
+				{"a", Payload{"alice", 1}},
+				{"z", Payload{"zed", 26}},
+				{"b", Payload{"bob", 2}},
+			},
+			want: []treemap.Entry[string, Payload]{
+				{Key: "a", Value: Payload{"alice", 1}},
+				{Key: "b", Value: Payload{"bob", 2}},
+				{Key: "m", Value: Payload{"mallory", 3}},
+				{Key: "z", Value: Payload{"zed", 26}},
+			},
+		},
+		{
+			name: "putting an existing key replaces its value",
+			tree: newMap(),
+			puts: []put{
+				{"a", Payload{"alice", 1}},
+				{"a", Payload{"alice", 100}},
+			},
+			want: []treemap.Entry[string, Payload]{
+				{Key: "a", Value: Payload{"alice", 100}},
+			},
+		},
+		{
+			name: "deleted keys are gone, missing keys are ignored",
+			tree: newMap(),
+			puts: []put{
+				{"a", Payload{"alice", 1}},
+				{"b", Payload{"bob", 2}},
+				{"c", Payload{"carol", 3}},
+			},
+			deletes: []string{"b", "x"},
+			want: []treemap.Entry[string, Payload]{
+				{Key: "a", Value: Payload{"alice", 1}},
+				{Key: "c", Value: Payload{"carol", 3}},
+			},
+		},
+		{
+			name: "custom key ordering",
+			tree: newMapFunc(func(a, b string) int {
+				return strings.Compare(strings.ToLower(a), strings.ToLower(b))
+			}),
+			puts: []put{
+				{"B", Payload{"bob", 2}},
+				{"a", Payload{"alice", 1}},
+				{"b", Payload{"bobby", 3}},
+			},
+			want: []treemap.Entry[string, Payload]{
+				{Key: "a", Value: Payload{"alice", 1}},
+				{Key: "b", Value: Payload{"bobby", 3}},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			for _, p := range tt.puts {
+				tt.tree.Put(p.key, p.value)
+			}
+			for _, k := range tt.deletes {
+				_, present := tt.tree.Get(k)
+				if got := tt.tree.Delete(k); got != present {
+					t.Errorf("TreeMap.Delete(%q) = %v, want %v", k, got, present)
+				}
+				if tt.tree.ContainsKey(k) {
+					t.Errorf("TreeMap.ContainsKey(%q) = true after Delete()", k)
+				}
+			}
+			var got []treemap.Entry[string, Payload]
+			tt.tree.InOrder(func(k string, v Payload) bool {
+				got = append(got, treemap.Entry[string, Payload]{Key: k, Value: v})
+				return true
+			})
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("TreeMap.InOrder() = %v, want %v", got, tt.want)
+			}
+			if tt.tree.Len() != len(tt.want) {
+				t.Errorf("TreeMap.Len() = %d, want %d", tt.tree.Len(), len(tt.want))
+			}
+			for _, e := range tt.want {
+				if v, found := tt.tree.Get(e.Key); !found || v != e.Value {
+					t.Errorf("TreeMap.Get(%q) = %v, %v, want %v, true", e.Key, v, found, e.Value)
+				}
+				if !tt.tree.ContainsKey(e.Key) {
+					t.Errorf("TreeMap.ContainsKey(%q) = false", e.Key)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,217 @@
+package treetest
+
+import (
+	"iter"
+	"math/rand"
+	"slices"
+	"testing"
+)
+
+// Tests shared by the bst, avl and redblack packages, run from each package's own tests
+// so that all three tree types are held to the same behaviour: Run for the tree API,
+// RunSet for the orderedset.OrderedSet one, and RunTreeMap for the TreeMaps built on bst and avl.
+
+// Tree :: interface :: The part of the tree API that bst.BST, avl.AVL and redblack.RedBlack share, over ints
+type Tree interface {
+	Add(t int)
+	Remove(obj int) (bool, error)
+	PreOrder(f func(t int) bool)
+	InOrder(f func(t int) bool)
+	PostOrder(f func(t int) bool)
+	LevelOrder(f func(t int) bool)
+	All() iter.Seq[int]
+	Backward() iter.Seq[int]
+	Size() int
+	Validate() error
+}
+
+// Run :: func :: Runs the shared tests against trees made by newTree.
+// found reports whether the tree's Find locates v, since each package's Find returns its own Node type.
+func Run[Tr Tree](t *testing.T, newTree func() Tr, found func(tree Tr, v int) bool) {
+	t.Run("Add", func(t *testing.T) {
+		for _, tt := range addTests {
+			t.Run(tt.name, func(t *testing.T) {
+				tree := newTree()
+				for _, v := range tt.values {
+					tree.Add(v)
+				}
+				check(t, tree, tt.want)
+				for _, v := range tt.values {
+					if !found(tree, v) {
+						t.Errorf("Find(%d) not found after Add()", v)
+					}
+				}
+				if found(tree, 0) {
+					t.Errorf("Find(0) found a value that was never added")
+				}
+			})
+		}
+	})
+
+	t.Run("Remove", func(t *testing.T) {
+		for _, tt := range removeTests {
+			t.Run(tt.name, func(t *testing.T) {
+				tree := newTree()
+				for _, v := range tt.values {
+					tree.Add(v)
+				}
+				removed, err := tree.Remove(tt.remove)
+				if (err != nil) != tt.wantErr || removed == tt.wantErr {
+					t.Errorf("Remove(%d) = %v, %v, wantErr %v", tt.remove, removed, err, tt.wantErr)
+				}
+				if found(tree, tt.remove) {
+					t.Errorf("Find(%d) still found after Remove()", tt.remove)
+				}
+				check(t, tree, tt.want)
+			})
+		}
+	})
+
+	t.Run("Traversals", func(t *testing.T) {
+		//        40
+		//    20      60
+		//  10  30  50  70
+		tree := newTree()
+		for _, v := range []int{40, 20, 60, 10, 30, 50, 70} {
+			tree.Add(v)
+		}
+		tests := []struct {
+			name     string
+			traverse func(f func(int) bool)
+			want     []int
+		}{
+			{name: "PreOrder", traverse: tree.PreOrder, want: []int{40, 20, 10, 30, 60, 50, 70}},
+			{name: "InOrder", traverse: tree.InOrder, want: []int{10, 20, 30, 40, 50, 60, 70}},
+			{name: "PostOrder", traverse: tree.PostOrder, want: []int{10, 30, 20, 50, 70, 60, 40}},
+			{name: "LevelOrder", traverse: tree.LevelOrder, want: []int{40, 20, 60, 10, 30, 50, 70}},
+			{name: "All", traverse: tree.All(), want: []int{10, 20, 30, 40, 50, 60, 70}},
+			{name: "Backward", traverse: tree.Backward(), want: []int{70, 60, 50, 40, 30, 20, 10}},
+			{name: "empty tree", traverse: newTree().InOrder},
+		}
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				if got := collect(tt.traverse); !slices.Equal(got, tt.want) {
+					t.Errorf("traversal = %v, want %v", got, tt.want)
+				}
+				// Stopping after each prefix should yield exactly that prefix
+				for stop := range tt.want {
+					var got []int
+					tt.traverse(func(v int) bool {
+						got = append(got, v)
+						return len(got) <= stop
+					})
+					if !slices.Equal(got, tt.want[:stop+1]) {
+						t.Errorf("traversal stopped after %d = %v, want %v", stop+1, got, tt.want[:stop+1])
+					}
+				}
+			})
+		}
+	})
+
+	t.Run("iterators read the tree when ranged over", func(t *testing.T) {
+		tree := newTree()
+		seqs := map[string]iter.Seq[int]{"All": tree.All(), "Backward": tree.Backward()}
+		tree.Add(1)
+		tree.Add(2)
+		for name, seq := range seqs {
+			if got := collect(seq); len(got) != 2 {
+				t.Errorf("%s() created before Add() yielded %v, want 2 values", name, got)
+			}
+		}
+	})
+
+	t.Run("Random", func(t *testing.T) {
+		rng := rand.New(rand.NewSource(1))
+		tree := newTree()
+		model := map[int]bool{}
+		for i := 0; i < 2000; i++ {
+			v := rng.Intn(200)
+			if rng.Intn(3) == 0 {
+				_, err := tree.Remove(v)
+				if (err == nil) != model[v] {
+					t.Fatalf("Remove(%d) error = %v, but present = %v", v, err, model[v])
+				}
+				delete(model, v)
+			} else {
+				tree.Add(v)
+				model[v] = true
+			}
+			if err := tree.Validate(); err != nil {
+				t.Fatalf("Validate() after operation %d: %v", i, err)
+			}
+		}
+		var want []int
+		for v := range model {
+			want = append(want, v)
+		}
+		slices.Sort(want)
+		check(t, tree, want)
+	})
+}
+
+// addTests :: var :: values to Add to an empty tree or set, and its contents afterwards in sort order
+var addTests = []struct {
+	name   string
+	values []int
+	want   []int
+}{
+	{name: "empty"},
+	{name: "single value", values: []int{1}, want: []int{1}},
+	{name: "values come back sorted", values: []int{5, -3, 8, 1, 4, 9, 2}, want: []int{-3, 1, 2, 4, 5, 8, 9}},
+	{name: "duplicate values are stored once", values: []int{2, 1, 2, 3, 1}, want: []int{1, 2, 3}},
+	{name: "ascending input", values: sequence(1, 64), want: sequence(1, 64)},
+	{name: "descending input", values: sequence(64, 1), want: sequence(1, 64)},
+}
+
+// removeTests :: var :: values to Add, then one to Remove, and the contents afterwards in sort order
+var removeTests = []struct {
+	name    string
+	values  []int
+	remove  int
+	want    []int
+	wantErr bool
+}{
+	{name: "empty", remove: 1, wantErr: true},
+	{name: "missing value", values: []int{2, 1, 3}, remove: 4, want: []int{1, 2, 3}, wantErr: true},
+	{name: "only value", values: []int{1}, remove: 1},
+	{name: "leaf", values: []int{2, 1, 3}, remove: 3, want: []int{1, 2}},
+	{name: "root with two children", values: []int{2, 1, 3}, remove: 2, want: []int{1, 3}},
+	{name: "inner node", values: sequence(1, 15), remove: 4, want: append(sequence(1, 3), sequence(5, 15)...)},
+	{name: "smallest value", values: sequence(1, 15), remove: 1, want: sequence(2, 15)},
+	{name: "largest value", values: sequence(1, 15), remove: 15, want: sequence(1, 14)},
+}
+
+// check :: func :: compares the tree's contents with want, which is sorted, and checks its invariants
+func check[Tr Tree](t *testing.T, tree Tr, want []int) {
+	t.Helper()
+	if got := collect(tree.InOrder); !slices.Equal(got, want) {
+		t.Errorf("InOrder() = %v, want %v", got, want)
+	}
+	if got := tree.Size(); got != len(want) {
+		t.Errorf("Size() = %d, want %d", got, len(want))
+	}
+	if err := tree.Validate(); err != nil {
+		t.Errorf("Validate() = %v", err)
+	}
+}
+
+func collect(traverse func(f func(int) bool)) []int {
+	var out []int
+	traverse(func(v int) bool {
+		out = append(out, v)
+		return true
+	})
+	return out
+}
+
+func sequence(start, end int) []int {
+	step := 1
+	if end < start {
+		step = -1
+	}
+	var out []int
+	for i := start; i != end+step; i += step {
+		out = append(out, i)
+	}
+	return out
+}
@@ -0,0 +1,93 @@
+package treewalk
+
+import (
+	"bytes"
+	"fmt"
+	"go-datastructures/stack"
+	"io"
+	"strings"
+)
+
+// Debug rendering shared by the binary trees, for seeing the shape of a tree rather than just its values.
+// Each tree supplies how to label its Nodes, so e.g. an AVL can show their Heights.
+
+// WriteDOT :: func :: Writes the tree below root to w as a Graphviz digraph called name, one vertex
+// per Node and its edges labelled L and R. attrs returns the attributes of n's vertex, such as
+// `label="40"`, given the Node above it, which is nil for root.
+func (t Nodes[N, T]) WriteDOT(w io.Writer, name string, root N, attrs func(n, parent N) string) error {
+	var none N
+	var buf bytes.Buffer
+	fmt.Fprintf(&buf, "digraph %s {\n\tnode [shape=circle];\n", name)
+	var nodes []N
+	ids := map[N]int{}
+	// Each level records itself as the parent of the next, the root's parent is nil
+	parents := map[N]N{}
+	t.Levels(root, func(level []N) bool {
+		for _, n := range level {
+			ids[n] = len(nodes)
+			nodes = append(nodes, n)
+			fmt.Fprintf(&buf, "\tn%d [%s];\n", ids[n], attrs(n, parents[n]))
+			left, right := t.Children(n)
+			parents[left], parents[right] = n, n
+		}
+		return true
+	})
+	for _, n := range nodes {
+		left, right := t.Children(n)
+		if left != none {
+			fmt.Fprintf(&buf, "\tn%d -> n%d [label=\"L\"];\n", ids[n], ids[left])
+		}
+		if right != none {
+			fmt.Fprintf(&buf, "\tn%d -> n%d [label=\"R\"];\n", ids[n], ids[right])
+		}
+	}
+	buf.WriteString("}\n")
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+// String :: func :: Renders the tree below root as ASCII, each Node written with label: root on the
+// first line, and each Node's children on the lines below it, left before right. When a Node has only
+// one child the missing one is drawn as nil, so left and right can't be confused.
+func (t Nodes[N, T]) String(root N, label func(n N) string) string {
+	var none N
+	if root == none {
+		return "(empty)"
+	}
+	var sb strings.Builder
+	pending := stack.New(line[N]{node: root})
+	for {
+		next, err := pending.Pop()
+		if err != nil {
+			break
+		}
+		sb.WriteString(next.prefix + next.branch)
+		if next.node == none {
+			sb.WriteString("nil\n")
+			continue
+		}
+		sb.WriteString(label(next.node) + "\n")
+		left, right := t.Children(next.node)
+		if left == none && right == none {
+			continue
+		}
+		prefix := next.prefix
+		switch next.branch {
+		case "├── ":
+			prefix += "│   "
+		case "└── ":
+			prefix += "    "
+		}
+		// Right goes on first so that Left comes back off first
+		pending.Add(line[N]{node: right, prefix: prefix, branch: "└── "})
+		pending.Add(line[N]{node: left, prefix: prefix, branch: "├── "})
+	}
+	return strings.TrimSuffix(sb.String(), "\n")
+}
+
+// line :: struct :: String stack entry, a Node still to be drawn below the lines already written
+type line[N comparable] struct {
+	node   N
+	prefix string
+	branch string
+}
@@ -0,0 +1,196 @@
+package treewalk
+
+import (
+	"go-datastructures/queue"
+	"go-datastructures/stack"
+)
+
+// Traversals shared by the binary trees. They keep their pending Nodes on a stack.Stack or
+// queue.Queue instead of recursing, so a degenerate (list-shaped) tree costs heap rather than
+// goroutine stack. Every traversal calls f with each value until f returns false, the same shape
+// as an iter.Seq yield function.
+
+// Nodes :: struct :: How to read the Nodes of a binary tree. N is the tree's Node pointer type,
+// whose zero value (nil) is an empty subtree, and T the type of the values stored in it.
+type Nodes[N comparable, T any] struct {
+	Value    func(n N) T
+	Children func(n N) (left, right N)
+}
+
+// PreOrder :: func :: Walks the tree below root current, left, right
+func (t Nodes[N, T]) PreOrder(root N, f func(T) bool) {
+	var none N
+	pending := stack.New[N]()
+	if root != none {
+		pending.Add(root)
+	}
+	for {
+		next, err := pending.Pop()
+		if err != nil {
+			return
+		}
+		if !f(t.Value(next)) {
+			return
+		}
+		// Right goes on first so that Left comes back off first
+		left, right := t.Children(next)
+		if right != none {
+			pending.Add(right)
+		}
+		if left != none {
+			pending.Add(left)
+		}
+	}
+}
+
+// InOrder :: func :: Walks the tree below root left, current, right, or right, current, left when backward is set
+func (t Nodes[N, T]) InOrder(root N, f func(T) bool, backward bool) {
+	var none N
+	pending := stack.New[N]()
+	for current := root; ; {
+		// Stack the whole near spine, the last one stacked is the next value
+		for current != none {
+			pending.Add(current)
+			current = t.near(current, backward)
+		}
+		next, err := pending.Pop()
+		if err != nil {
+			return
+		}
+		if !f(t.Value(next)) {
+			return
+		}
+		current = t.near(next, !backward)
+	}
+}
+
+// visit :: struct :: PostOrder stack entry, expanded once the Node's children have been stacked above it
+type visit[N comparable] struct {
+	node     N
+	expanded bool
+}
+
+// PostOrder :: func :: Walks the tree below root left, right, current
+func (t Nodes[N, T]) PostOrder(root N, f func(T) bool) {
+	var none N
+	pending := stack.New[visit[N]]()
+	if root != none {
+		pending.Add(visit[N]{node: root})
+	}
+	for {
+		next, err := pending.Pop()
+		if err != nil {
+			return
+		}
+		if next.expanded {
+			if !f(t.Value(next.node)) {
+				return
+			}
+			continue
+		}
+		// Children are stacked above their parent so they're processed first, Left before Right
+		pending.Add(visit[N]{node: next.node, expanded: true})
+		left, right := t.Children(next.node)
+		if right != none {
+			pending.Add(visit[N]{node: right})
+		}
+		if left != none {
+			pending.Add(visit[N]{node: left})
+		}
+	}
+}
+
+// LevelOrder :: func :: Walks the tree below root breadth-first, each level left to right
+func (t Nodes[N, T]) LevelOrder(root N, f func(T) bool) {
+	var none N
+	pending := queue.New[N]()
+	if root != none {
+		pending.Add(root)
+	}
+	for {
+		next, err := pending.Dequeue()
+		if err != nil {
+			return
+		}
+		if !f(t.Value(next)) {
+			return
+		}
+		left, right := t.Children(next)
+		if left != none {
+			pending.Add(left)
+		}
+		if right != none {
+			pending.Add(right)
+		}
+	}
+}
+
+// Levels :: func :: Calls f with the Nodes of each level below root in turn, each left to right,
+// until f returns false
+func (t Nodes[N, T]) Levels(root N, f func(level []N) bool) {
+	var none N
+	var level []N
+	if root != none {
+		level = append(level, root)
+	}
+	for len(level) > 0 {
+		if !f(level) {
+			return
+		}
+		var next []N
+		for _, n := range level {
+			left, right := t.Children(n)
+			if left != none {
+				next = append(next, left)
+			}
+			if right != none {
+				next = append(next, right)
+			}
+		}
+		level = next
+	}
+}
+
+// Range :: func :: Walks the values v below root with lo <= v <= hi in order, compared with compare.
+// Subtrees that fall entirely outside the interval are never entered, so visiting k values costs
+// O(height + k).
+func (t Nodes[N, T]) Range(root N, lo, hi T, compare func(a, b T) int, f func(T) bool) {
+	var none N
+	pending := stack.New[N]()
+	for current := root; ; {
+		// Stack the left spine, skipping over Nodes below lo: only their right subtree can be in range
+		for current != none {
+			left, right := t.Children(current)
+			switch c := compare(t.Value(current), lo); {
+			case c < 0:
+				current = right
+			case c == 0:
+				// Everything on the left is below lo
+				pending.Add(current)
+				current = none
+			default:
+				pending.Add(current)
+				current = left
+			}
+		}
+		next, err := pending.Pop()
+		if err != nil {
+			return
+		}
+		// Values come off in order, so the first one past hi ends the walk
+		value := t.Value(next)
+		if compare(value, hi) > 0 || !f(value) {
+			return
+		}
+		_, current = t.Children(next)
+	}
+}
+
+// near :: func :: returns n's Left child, or its Right when backward is set
+func (t Nodes[N, T]) near(n N, backward bool) N {
+	left, right := t.Children(n)
+	if backward {
+		return right
+	}
+	return left
+}
@@ -0,0 +1,145 @@
+package treewalk
+
+import (
+	"math/rand"
+	"slices"
+	"testing"
+)
+
+type node struct {
+	value       int
+	left, right *node
+}
+
+var nodes = Nodes[*node, int]{
+	Value:    func(n *node) int { return n.value },
+	Children: func(n *node) (*node, *node) { return n.left, n.right },
+}
+
+func insert(n *node, v int) *node {
+	if n == nil {
+		return &node{value: v}
+	}
+	if v < n.value {
+		n.left = insert(n.left, v)
+	} else if v > n.value {
+		n.right = insert(n.right, v)
+	}
+	return n
+}
+
+// recursive :: func :: the textbook recursive walks to compare against, order being "pre", "in" or "post"
+func recursive(n *node, order string, out *[]int) {
+	if n == nil {
+		return
+	}
+	if order == "pre" {
+		*out = append(*out, n.value)
+	}
+	recursive(n.left, order, out)
+	if order == "in" {
+		*out = append(*out, n.value)
+	}
+	recursive(n.right, order, out)
+	if order == "post" {
+		*out = append(*out, n.value)
+	}
+}
+
+// collect :: func :: returns the values a walk visits, stopping it after limit of them when limit > 0
+func collect(walk func(f func(int) bool), limit int) []int {
+	var out []int
+	walk(func(v int) bool {
+		out = append(out, v)
+		return limit <= 0 || len(out) < limit
+	})
+	return out
+}
+
+func TestNodes(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+	for _, size := range []int{0, 1, 2, 10, 100} {
+		var root *node
+		for i := 0; i < size; i++ {
+			root = insert(root, rng.Intn(3*size))
+		}
+		for order, walk := range map[string]func(f func(int) bool){
+			"pre":  func(f func(int) bool) { nodes.PreOrder(root, f) },
+			"in":   func(f func(int) bool) { nodes.InOrder(root, f, false) },
+			"post": func(f func(int) bool) { nodes.PostOrder(root, f) },
+		} {
+			var want []int
+			recursive(root, order, &want)
+			if got := collect(walk, 0); !slices.Equal(got, want) {
+				t.Errorf("size %d: %s-order = %v, want %v", size, order, got, want)
+			}
+			if got := collect(walk, 3); !slices.Equal(got, want[:min(3, len(want))]) {
+				t.Errorf("size %d: %s-order stopped after 3 = %v, want %v", size, order, got, want[:min(3, len(want))])
+			}
+		}
+		var sorted []int
+		recursive(root, "in", &sorted)
+		backward := collect(func(f func(int) bool) { nodes.InOrder(root, f, true) }, 0)
+		if !slices.Equal(backward, reversed(sorted)) {
+			t.Errorf("size %d: backward in-order = %v", size, backward)
+		}
+		if got := collect(func(f func(int) bool) { nodes.LevelOrder(root, f) }, 0); len(got) != len(sorted) || (root != nil && got[0] != root.value) {
+			t.Errorf("size %d: level-order = %v", size, got)
+		}
+		// Levels laid end to end are the level-order walk
+		var levels []int
+		nodes.Levels(root, func(level []*node) bool {
+			for _, n := range level {
+				levels = append(levels, n.value)
+			}
+			return true
+		})
+		if want := collect(func(f func(int) bool) { nodes.LevelOrder(root, f) }, 0); !slices.Equal(levels, want) {
+			t.Errorf("size %d: Levels() = %v, want %v", size, levels, want)
+		}
+		// Every interval, including empty and reversed ones, against filtering the sorted values
+		for lo := -1; lo <= 3*size; lo++ {
+			for hi := lo - 1; hi <= 3*size; hi++ {
+				var want []int
+				for _, v := range sorted {
+					if lo <= v && v <= hi {
+						want = append(want, v)
+					}
+				}
+				got := collect(func(f func(int) bool) {
+					nodes.Range(root, lo, hi, func(a, b int) int { return a - b }, f)
+				}, 0)
+				if !slices.Equal(got, want) {
+					t.Fatalf("size %d: Range(%d, %d) = %v, want %v", size, lo, hi, got, want)
+				}
+			}
+		}
+	}
+}
+
+func TestNodes_Degenerate(t *testing.T) {
+	// A list-shaped tree deep enough that a recursive walk would be felt, built without recursion
+	const depth = 100_000
+	root := &node{value: 0}
+	for n, v := root, 1; v < depth; v++ {
+		n.right = &node{value: v}
+		n = n.right
+	}
+	count := 0
+	nodes.Range(root, 10, depth, func(a, b int) int { return a - b }, func(int) bool {
+		count++
+		return true
+	})
+	if count != depth-10 {
+		t.Errorf("Range() visited %d values, want %d", count, depth-10)
+	}
+	if got := collect(func(f func(int) bool) { nodes.PostOrder(root, f) }, 0); len(got) != depth || got[0] != depth-1 {
+		t.Errorf("PostOrder() visited %d values starting at %v", len(got), got[0])
+	}
+}
+
+func reversed(s []int) []int {
+	out := slices.Clone(s)
+	slices.Reverse(out)
+	return out
+}